@@ -0,0 +1,146 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package cache provides a caching layer for ent queries. Query results are
+// keyed on their SQL and arguments, stored in a pluggable Cache, and invalidated
+// by the mutations that are executed on their tables.
+//
+// The caching layer consists of a driver that wraps the SQL driver of the client,
+// an interceptor that opts queries into caching, and a hook that ensures mutations
+// never read cached results:
+//
+//	drv := cache.NewDriver(sqlDriver, cache.TTL(time.Minute))
+//	client := ent.NewClient(ent.Driver(drv))
+//	client.Intercept(cache.Interceptor())
+//	client.Use(cache.Hook())
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+)
+
+// ErrNotFound is returned by Cache implementations when an entry was not found.
+var ErrNotFound = errors.New("cache: entry was not found")
+
+type (
+	// Cache is the interface implemented by the stores of query results.
+	Cache interface {
+		// Get returns the entry stored under the given key, or ErrNotFound
+		// if the entry does not exist or has expired.
+		Get(ctx context.Context, key string) (*Entry, error)
+		// Set stores the entry under the given key. A positive ttl
+		// sets the duration the entry is valid for.
+		Set(ctx context.Context, key string, e *Entry, ttl time.Duration) error
+		// Invalidate removes all entries that depend on the given tables.
+		Invalidate(ctx context.Context, tables ...string) error
+	}
+
+	// Entry holds the result of a query.
+	Entry struct {
+		// Columns and Values hold the result set of the query.
+		Columns []string
+		Values  [][]driver.Value
+		// Tables holds the identifiers that are referenced by the
+		// query. A superset of the tables the entry depends on.
+		Tables []string
+	}
+)
+
+// Key returns the cache key of the given query and its arguments. Sensitive
+// arguments are keyed by their underlying values, as they are redacted when
+// formatted.
+func Key(query string, args []any) string {
+	h := sha256.New()
+	fmt.Fprint(h, query)
+	for _, arg := range args {
+		for {
+			s, ok := arg.(dialect.Sensitive)
+			if !ok {
+				break
+			}
+			arg = s.V
+		}
+		fmt.Fprintf(h, "\x00%T:%v", arg, arg)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type (
+	// ctxOptions holds the caching options of a query.
+	ctxOptions struct {
+		skip   bool
+		enable bool
+		ttl    time.Duration
+	}
+	ctxKey struct{}
+)
+
+func optionsFromContext(ctx context.Context) ctxOptions {
+	opts, _ := ctx.Value(ctxKey{}).(ctxOptions)
+	return opts
+}
+
+// Skip returns a new context that skips the cache for the queries that
+// are executed with it. i.e. results are not read from or stored to the
+// cache.
+func Skip(parent context.Context) context.Context {
+	opts := optionsFromContext(parent)
+	opts.skip = true
+	return context.WithValue(parent, ctxKey{}, opts)
+}
+
+// WithTTL returns a new context that enables caching for the queries that
+// are executed with it, and sets the duration their results are valid for.
+func WithTTL(parent context.Context, ttl time.Duration) context.Context {
+	opts := optionsFromContext(parent)
+	opts.enable, opts.ttl = true, ttl
+	return context.WithValue(parent, ctxKey{}, opts)
+}
+
+// enable returns a new context that enables caching for the queries
+// that are executed with it, unless caching was explicitly skipped.
+func enable(parent context.Context) context.Context {
+	opts := optionsFromContext(parent)
+	if opts.skip || opts.enable {
+		return parent
+	}
+	opts.enable = true
+	return context.WithValue(parent, ctxKey{}, opts)
+}
+
+// Interceptor returns an interceptor that enables caching for the queries
+// it intercepts. It can be registered on the client to cache the queries of
+// all types, or on a specific type client. For example:
+//
+//	client.Intercept(cache.Interceptor())
+//	client.User.Intercept(cache.Interceptor())
+//
+// Note that the results are cached only if the client driver is a cache.Driver.
+func Interceptor() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			return next.Query(enable(ctx), q)
+		})
+	})
+}
+
+// Hook returns a hook that skips the cache for all queries that are executed
+// during mutations (e.g., by other hooks or privacy rules), as mutations should
+// not base their decisions on cached results.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			return next.Mutate(Skip(ctx), m)
+		})
+	}
+}
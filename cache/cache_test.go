@@ -0,0 +1,66 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	k1 := Key("SELECT * FROM `users` WHERE `id` = ?", []any{1})
+	require.Equal(t, k1, Key("SELECT * FROM `users` WHERE `id` = ?", []any{1}))
+	require.NotEqual(t, k1, Key("SELECT * FROM `users` WHERE `id` = ?", []any{2}))
+	require.NotEqual(t, k1, Key("SELECT * FROM `users` WHERE `id` = ?", []any{"1"}))
+	require.NotEqual(t, k1, Key("SELECT * FROM `pets` WHERE `id` = ?", []any{1}))
+	k2 := Key("SELECT * FROM `users` WHERE `token` = ?", []any{dialect.Sensitive{V: "alice-token"}})
+	require.Equal(t, k2, Key("SELECT * FROM `users` WHERE `token` = ?", []any{dialect.Sensitive{V: "alice-token"}}))
+	require.NotEqual(t, k2, Key("SELECT * FROM `users` WHERE `token` = ?", []any{dialect.Sensitive{V: "bob-token"}}))
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, ctxOptions{}, optionsFromContext(ctx))
+	require.Equal(t, ctxOptions{enable: true}, optionsFromContext(enable(ctx)))
+	require.Equal(t, ctxOptions{enable: true, ttl: time.Second}, optionsFromContext(WithTTL(ctx, time.Second)))
+	require.Equal(t, ctxOptions{skip: true}, optionsFromContext(enable(Skip(ctx))))
+	require.Equal(t, ctxOptions{enable: true, ttl: time.Second}, optionsFromContext(enable(WithTTL(ctx, time.Second))))
+}
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+	_, err := c.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.Set(ctx, "a", &Entry{Tables: []string{"users"}}, 0))
+	require.NoError(t, c.Set(ctx, "b", &Entry{Tables: []string{"pets"}}, 0))
+	_, err = c.Get(ctx, "a")
+	require.NoError(t, err)
+	// "b" is the least recently used entry.
+	require.NoError(t, c.Set(ctx, "c", &Entry{Tables: []string{"users", "pets"}}, 0))
+	require.Equal(t, 2, c.Len())
+	_, err = c.Get(ctx, "b")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.Invalidate(ctx, "pets"))
+	require.Equal(t, 1, c.Len())
+	_, err = c.Get(ctx, "c")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, c.Invalidate(ctx, "users"))
+	require.Zero(t, c.Len())
+	require.Empty(t, c.tables)
+
+	require.NoError(t, c.Set(ctx, "a", &Entry{}, time.Nanosecond))
+	time.Sleep(time.Millisecond)
+	_, err = c.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Zero(t, c.Len())
+}
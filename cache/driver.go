@@ -0,0 +1,453 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package cache
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+type (
+	// Driver is a dialect.Driver that caches the results of queries
+	// that opted into caching, and invalidates them on writes.
+	Driver struct {
+		dialect.Driver
+		cache Cache
+		ttl   time.Duration
+		// versions holds the invalidation counters of the tables,
+		// and it is used to avoid caching results that were read
+		// concurrently with a write to one of their tables.
+		mu       sync.Mutex
+		versions map[string]uint64
+	}
+
+	// Option allows configuring the Driver.
+	Option func(*Driver)
+)
+
+var _ dialect.Driver = (*Driver)(nil)
+
+// WithCache sets the Cache that stores the query results.
+// Defaults to an LRU cache with 1024 entries.
+func WithCache(c Cache) Option {
+	return func(d *Driver) {
+		d.cache = c
+	}
+}
+
+// TTL sets the default duration the cached results are valid for.
+// A zero duration (the default) means results are valid until invalidated.
+func TTL(ttl time.Duration) Option {
+	return func(d *Driver) {
+		d.ttl = ttl
+	}
+}
+
+// NewDriver returns a new Driver that wraps the given SQL driver.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{
+		Driver:   drv,
+		versions: make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.cache == nil {
+		d.cache = NewLRU(1024)
+	}
+	return d
+}
+
+// Cache returns the Cache used by the driver.
+func (d *Driver) Cache() Cache {
+	return d.cache
+}
+
+// Exec executes the statement and invalidates the tables it writes to.
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	if err := d.Driver.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+	return d.invalidate(ctx, d.writes(query)...)
+}
+
+// Query executes the query, or replays its result from the cache if the
+// query opted into caching. Write statements (e.g., INSERT ... RETURNING)
+// invalidate the tables they write to.
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	if !isRead(query) {
+		if err := d.Driver.Query(ctx, query, args, v); err != nil {
+			return err
+		}
+		return d.invalidate(ctx, d.writes(query)...)
+	}
+	opts := optionsFromContext(ctx)
+	rows, ok := v.(*sql.Rows)
+	if !ok || opts.skip || !opts.enable {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	argv, ok := args.([]any)
+	if !ok && args != nil {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	key := Key(query, argv)
+	switch e, err := d.cache.Get(ctx, key); {
+	case err == nil:
+		return replay(ctx, e, rows)
+	case !errors.Is(err, ErrNotFound):
+		return fmt.Errorf("cache: get entry: %w", err)
+	}
+	tables := identifiers(query, d.Dialect())
+	versions := d.snapshot(tables)
+	e, err := d.read(ctx, query, args)
+	if err != nil {
+		return err
+	}
+	e.Tables = tables
+	if d.unchanged(tables, versions) {
+		ttl := d.ttl
+		if opts.ttl > 0 {
+			ttl = opts.ttl
+		}
+		if err := d.cache.Set(ctx, key, e, ttl); err != nil {
+			return fmt.Errorf("cache: set entry: %w", err)
+		}
+	}
+	return replay(ctx, e, rows)
+}
+
+// Tx starts a transaction that bypasses the cache. The tables that were
+// written in the transaction are invalidated after it was committed.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d, ctx: ctx}, nil
+}
+
+// BeginTx calls the underlying driver BeginTx command if it is supported.
+// Like Tx, the returned transaction bypasses the cache.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d, ctx: ctx}, nil
+}
+
+// read executes the query and reads all its rows.
+func (d *Driver) read(ctx context.Context, query string, args any) (*Entry, error) {
+	rows := &sql.Rows{}
+	if err := d.Driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	e := &Entry{Columns: columns}
+	for rows.Next() {
+		values := make([]any, len(columns))
+		for i := range values {
+			values[i] = new(any)
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		row := make([]driver.Value, len(columns))
+		for i := range values {
+			row[i] = *values[i].(*any)
+		}
+		e.Values = append(e.Values, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return e, rows.Close()
+}
+
+// writes returns the tables that are written by the given statement.
+func (d *Driver) writes(query string) []string {
+	if t, ok := target(query, d.Dialect()); ok {
+		return []string{t}
+	}
+	// Statements with unknown targets (e.g., DDL) invalidate
+	// all tables that are referenced by them.
+	return identifiers(query, d.Dialect())
+}
+
+// invalidate removes the cached entries of the given tables.
+func (d *Driver) invalidate(ctx context.Context, tables ...string) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	d.mu.Lock()
+	for _, t := range tables {
+		d.versions[t]++
+	}
+	d.mu.Unlock()
+	if err := d.cache.Invalidate(ctx, tables...); err != nil {
+		return fmt.Errorf("cache: invalidate entries: %w", err)
+	}
+	return nil
+}
+
+// snapshot returns the invalidation counters of the given tables.
+func (d *Driver) snapshot(tables []string) []uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	versions := make([]uint64, len(tables))
+	for i, t := range tables {
+		versions[i] = d.versions[t]
+	}
+	return versions
+}
+
+// unchanged reports if the given tables were not invalidated since the snapshot was taken.
+func (d *Driver) unchanged(tables []string, versions []uint64) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, t := range tables {
+		if d.versions[t] != versions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Tx is a transaction of the cache driver. Its queries are never cached.
+type Tx struct {
+	dialect.Tx
+	drv *Driver
+	ctx context.Context
+	mu  sync.Mutex
+	// tables that were written in the transaction.
+	tables []string
+}
+
+var _ dialect.Tx = (*Tx)(nil)
+
+// Exec executes the statement and records the tables it writes to.
+func (tx *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	if err := tx.Tx.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+	tx.record(query)
+	return nil
+}
+
+// Query executes the query and records the tables it writes to, if any.
+func (tx *Tx) Query(ctx context.Context, query string, args, v any) error {
+	if err := tx.Tx.Query(ctx, query, args, v); err != nil {
+		return err
+	}
+	if !isRead(query) {
+		tx.record(query)
+	}
+	return nil
+}
+
+// Commit commits the transaction and invalidates the tables that were written in it.
+func (tx *Tx) Commit() error {
+	if err := tx.Tx.Commit(); err != nil {
+		return err
+	}
+	tx.mu.Lock()
+	tables := tx.tables
+	tx.tables = nil
+	tx.mu.Unlock()
+	return tx.drv.invalidate(tx.ctx, tables...)
+}
+
+func (tx *Tx) record(query string) {
+	tables := tx.drv.writes(query)
+	tx.mu.Lock()
+	tx.tables = append(tx.tables, tables...)
+	tx.mu.Unlock()
+}
+
+// isRead reports if the given query is a read that can be cached.
+// Locking reads (e.g., SELECT ... FOR UPDATE) are not cached.
+func isRead(query string) bool {
+	q := strings.ToUpper(strings.TrimSpace(query))
+	if !strings.HasPrefix(q, "SELECT") && !strings.HasPrefix(q, "WITH") {
+		return false
+	}
+	for _, kw := range []string{" FOR UPDATE", " FOR SHARE", " FOR NO KEY UPDATE", " FOR KEY SHARE", " LOCK IN SHARE MODE"} {
+		if strings.Contains(q, kw) {
+			return false
+		}
+	}
+	if strings.HasPrefix(q, "WITH") {
+		for _, kw := range []string{"INSERT INTO", "UPDATE ", "DELETE FROM"} {
+			if strings.Contains(q, kw) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// target returns the table that is written by the given INSERT, UPDATE or DELETE statement.
+func target(query, name string) (string, bool) {
+	q := strings.TrimSpace(query)
+	for _, kw := range []string{"INSERT INTO ", "INSERT IGNORE INTO ", "REPLACE INTO ", "UPDATE ", "DELETE FROM "} {
+		if len(q) < len(kw) || !strings.EqualFold(q[:len(kw)], kw) {
+			continue
+		}
+		var (
+			t  string
+			ok bool
+			s  = strings.TrimSpace(q[len(kw):])
+		)
+		// Take the last part of schema-qualified names.
+		for {
+			if t, s, ok = ident(s, quote(name)); !ok {
+				return "", false
+			}
+			if !strings.HasPrefix(s, ".") {
+				return t, true
+			}
+			s = s[1:]
+		}
+	}
+	return "", false
+}
+
+// identifiers returns all unique quoted identifiers in the query.
+func identifiers(query, name string) []string {
+	var (
+		ids  []string
+		seen = make(map[string]struct{})
+		q    = quote(name)
+	)
+	for s := query; ; {
+		i := strings.IndexByte(s, q)
+		if i == -1 {
+			return ids
+		}
+		id, rest, ok := ident(s[i:], q)
+		if !ok {
+			return ids
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+		s = rest
+	}
+}
+
+// ident reads a quoted identifier from the beginning of s.
+func ident(s string, q byte) (string, string, bool) {
+	if len(s) == 0 || s[0] != q {
+		return "", "", false
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != q {
+			b.WriteByte(s[i])
+			continue
+		}
+		// Escaped quote.
+		if i+1 < len(s) && s[i+1] == q {
+			b.WriteByte(q)
+			i++
+			continue
+		}
+		return b.String(), s[i+1:], true
+	}
+	return "", "", false
+}
+
+// quote returns the identifier quote character of the dialect.
+func quote(name string) byte {
+	if name == dialect.Postgres {
+		return '"'
+	}
+	return '`'
+}
+
+// replayDB is an in-process database that replays cached
+// entries as standard rows. The entry is passed as the single
+// argument of the query.
+var replayDB = stdsql.OpenDB(replayConnector{})
+
+// replay sets the rows of the given entry to v.
+func replay(ctx context.Context, e *Entry, v *sql.Rows) error {
+	rows, err := replayDB.QueryContext(ctx, "", e)
+	if err != nil {
+		return err
+	}
+	v.ColumnScanner = rows
+	return nil
+}
+
+type (
+	replayConnector struct{}
+	replayConn      struct{}
+	replayRows      struct {
+		*Entry
+		pos int
+	}
+)
+
+func (replayConnector) Connect(context.Context) (driver.Conn, error) { return replayConn{}, nil }
+func (replayConnector) Driver() driver.Driver                        { return replayConnector{} }
+func (replayConnector) Open(string) (driver.Conn, error)             { return replayConn{}, nil }
+
+func (replayConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("cache: prepare is not supported")
+}
+func (replayConn) Close() error { return nil }
+func (replayConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("cache: transactions are not supported")
+}
+
+// CheckNamedValue accepts the entries as query arguments.
+func (replayConn) CheckNamedValue(v *driver.NamedValue) error {
+	if _, ok := v.Value.(*Entry); !ok {
+		return fmt.Errorf("cache: unexpected argument %T", v.Value)
+	}
+	return nil
+}
+
+// QueryContext returns the rows of the entry argument.
+func (replayConn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("cache: unexpected number of arguments: %d", len(args))
+	}
+	return &replayRows{Entry: args[0].Value.(*Entry)}, nil
+}
+
+func (r *replayRows) Columns() []string { return r.Entry.Columns }
+func (r *replayRows) Close() error      { return nil }
+
+func (r *replayRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.Values) {
+		return io.EOF
+	}
+	copy(dest, r.Values[r.pos])
+	r.pos++
+	return nil
+}
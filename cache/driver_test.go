@@ -0,0 +1,141 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package cache
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := NewDriver(sql.OpenDB(dialect.MySQL, db))
+	var (
+		ctx    = enable(context.Background())
+		query  = "SELECT `id`, `name` FROM `users` WHERE `id` = ?"
+		expect = func() {
+			mock.ExpectQuery(regexp.QuoteMeta(query)).
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+		}
+		scan = func(ctx context.Context) {
+			rows := &sql.Rows{}
+			require.NoError(t, drv.Query(ctx, query, []any{1}, rows))
+			columns, err := rows.Columns()
+			require.NoError(t, err)
+			require.Equal(t, []string{"id", "name"}, columns)
+			var (
+				id   int
+				name string
+			)
+			require.True(t, rows.Next())
+			require.NoError(t, rows.Scan(&id, &name))
+			require.Equal(t, 1, id)
+			require.Equal(t, "a8m", name)
+			require.False(t, rows.Next())
+			require.NoError(t, rows.Close())
+		}
+	)
+
+	// The first query populates the cache, and the second is replayed from it.
+	expect()
+	scan(ctx)
+	scan(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Skipped and non-enabled queries are not read from the cache.
+	expect()
+	scan(Skip(ctx))
+	expect()
+	scan(context.Background())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Writes to other tables do not invalidate the entry.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `pets` SET `name` = ?")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "UPDATE `pets` SET `name` = ?", []any{"pedro"}, nil))
+	scan(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Writes to the table invalidate the entry.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `name` = ?")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "UPDATE `users` SET `name` = ?", []any{"a8m"}, nil))
+	expect()
+	scan(ctx)
+	scan(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Nothing is cached inside a transaction, and its
+	// writes invalidate the entries only on commit.
+	mock.ExpectBegin()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `users`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users`", []any{}, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	rows := &sql.Rows{}
+	require.NoError(t, tx.Query(ctx, query, []any{1}, rows))
+	require.False(t, rows.Next())
+	require.NoError(t, rows.Close())
+	scan(ctx)
+	mock.ExpectCommit()
+	require.NoError(t, tx.Commit())
+	expect()
+	scan(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Rolled back transactions do not invalidate entries.
+	mock.ExpectBegin()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `users`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users`", []any{}, nil))
+	mock.ExpectRollback()
+	require.NoError(t, tx.Rollback())
+	scan(ctx)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		query, dialect string
+		table          string
+		ok             bool
+	}{
+		{"INSERT INTO `users` (`name`) VALUES (?)", dialect.MySQL, "users", true},
+		{`INSERT INTO "users" ("name") VALUES ($1) RETURNING "id"`, dialect.Postgres, "users", true},
+		{`UPDATE "public"."users" SET "name" = $1`, dialect.Postgres, "users", true},
+		{"delete from `user_friends` WHERE `user_id` = ?", dialect.SQLite, "user_friends", true},
+		{"SELECT * FROM `users`", dialect.SQLite, "", false},
+		{"CREATE TABLE `users` (`id` integer)", dialect.SQLite, "", false},
+	}
+	for _, tt := range tests {
+		table, ok := target(tt.query, tt.dialect)
+		require.Equal(t, tt.ok, ok, tt.query)
+		require.Equal(t, tt.table, table, tt.query)
+	}
+	require.Equal(t, []string{"users", "id", "pets", "a\"b"}, identifiers(`SELECT "users"."id" FROM "users" JOIN "pets" JOIN "a""b"`, dialect.Postgres))
+}
+
+func TestIsRead(t *testing.T) {
+	require.True(t, isRead("SELECT * FROM `users`"))
+	require.True(t, isRead(`WITH "users" AS (SELECT 1) SELECT * FROM "users"`))
+	require.False(t, isRead("SELECT * FROM `users` FOR UPDATE"))
+	require.False(t, isRead(`INSERT INTO "users" DEFAULT VALUES RETURNING "id"`))
+	require.False(t, isRead(`WITH "t" AS (DELETE FROM "users" RETURNING *) SELECT * FROM "t"`))
+}
@@ -0,0 +1,116 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// LRU is an in-memory Cache that evicts the least recently used
+// entries when it reaches its maximum size. It is safe for concurrent use.
+type LRU struct {
+	mu     sync.Mutex
+	size   int
+	list   *list.List
+	items  map[string]*list.Element
+	tables map[string]map[string]struct{}
+}
+
+// lruItem is the value stored in the LRU list.
+type lruItem struct {
+	key    string
+	entry  *Entry
+	expire time.Time
+}
+
+var _ Cache = (*LRU)(nil)
+
+// NewLRU returns an LRU cache that holds at most size entries.
+// A non-positive size means there is no limit on the number of entries.
+func NewLRU(size int) *LRU {
+	return &LRU{
+		size:   size,
+		list:   list.New(),
+		items:  make(map[string]*list.Element),
+		tables: make(map[string]map[string]struct{}),
+	}
+}
+
+// Get implements the Cache interface.
+func (c *LRU) Get(_ context.Context, key string) (*Entry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	item := e.Value.(*lruItem)
+	if !item.expire.IsZero() && time.Now().After(item.expire) {
+		c.remove(e)
+		return nil, ErrNotFound
+	}
+	c.list.MoveToFront(e)
+	return item.entry, nil
+}
+
+// Set implements the Cache interface.
+func (c *LRU) Set(_ context.Context, key string, entry *Entry, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+	}
+	item := &lruItem{key: key, entry: entry}
+	if ttl > 0 {
+		item.expire = time.Now().Add(ttl)
+	}
+	c.items[key] = c.list.PushFront(item)
+	for _, t := range entry.Tables {
+		if c.tables[t] == nil {
+			c.tables[t] = make(map[string]struct{})
+		}
+		c.tables[t][key] = struct{}{}
+	}
+	if c.size > 0 && c.list.Len() > c.size {
+		c.remove(c.list.Back())
+	}
+	return nil
+}
+
+// Invalidate implements the Cache interface.
+func (c *LRU) Invalidate(_ context.Context, tables ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, t := range tables {
+		for key := range c.tables[t] {
+			if e, ok := c.items[key]; ok {
+				c.remove(e)
+			}
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Len()
+}
+
+// remove removes the given element from the cache. The caller must hold the lock.
+func (c *LRU) remove(e *list.Element) {
+	item := c.list.Remove(e).(*lruItem)
+	delete(c.items, item.key)
+	for _, t := range item.entry.Tables {
+		delete(c.tables[t], item.key)
+		if len(c.tables[t]) == 0 {
+			delete(c.tables, t)
+		}
+	}
+}
@@ -206,3 +206,40 @@ func (d *DebugTx) Rollback() error {
 	d.log(d.ctx, fmt.Sprintf("Tx(%s): rollbacked", d.id))
 	return d.Tx.Rollback()
 }
+
+// Sensitive wraps an argument value that must not be exposed in logs. The value is passed
+// as-is to the database driver, but it is printed as "<sensitive>" by the fmt package, and
+// therefore, by the DebugDriver and DebugTx loggers.
+type Sensitive struct {
+	V any // underlying value.
+}
+
+// Value implements the driver.Valuer interface. Values that implement the driver.Valuer
+// interface are delegated to, and values that cannot be converted to one of the basic driver
+// types are passed unchanged, to be handled by the driver (e.g. its NamedValueChecker).
+func (s Sensitive) Value() (driver.Value, error) {
+	switch v := s.V.(type) {
+	case nil:
+		return nil, nil
+	case driver.Valuer:
+		return v.Value()
+	}
+	if driver.IsValue(s.V) {
+		return s.V, nil
+	}
+	if v, err := driver.DefaultParameterConverter.ConvertValue(s.V); err == nil {
+		return v, nil
+	}
+	return s.V, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (Sensitive) String() string {
+	return "<sensitive>"
+}
+
+// Format implements the fmt.Formatter interface, and redacts the
+// value regardless of the formatting verb or flags.
+func (s Sensitive) Format(f fmt.State, _ rune) {
+	fmt.Fprint(f, s.String())
+}
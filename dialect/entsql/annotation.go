@@ -193,6 +193,33 @@ type Annotation struct {
 	//	}
 	ViewFor map[string]string `json:"view_for,omitempty"`
 
+	// History indicates that changes to the schema rows are recorded in a
+	// history table. Used by the "sql/history" codegen feature.
+	//
+	//	entsql.Annotation{
+	//		History: true,
+	//	}
+	//
+	History bool `json:"history,omitempty"`
+
+	// Outbox indicates that committed mutations of the schema write events
+	// to the outbox table. Used by the "sql/outbox" codegen feature.
+	//
+	//	entsql.Annotation{
+	//		Outbox: true,
+	//	}
+	//
+	Outbox bool `json:"outbox,omitempty"`
+
+	// Tenant defines the name of the field that holds the tenant of the
+	// schema rows. Used by the "sql/tenancy" codegen feature.
+	//
+	//	entsql.Annotation{
+	//		Tenant: "tenant_id",
+	//	}
+	//
+	Tenant string `json:"tenant,omitempty"`
+
 	// error occurs during annotation build. This field is not
 	// serialized to JSON and used only by the codegen loader.
 	err error
@@ -270,6 +297,46 @@ func Skip() *Annotation {
 	return &Annotation{Skip: true}
 }
 
+// History indicates that changes to the schema rows are recorded
+// in a history table. Note, this option requires enabling the
+// "sql/history" codegen feature.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.History(),
+//		}
+//	}
+func History() *Annotation {
+	return &Annotation{History: true}
+}
+
+// Outbox indicates that committed mutations of the schema write
+// events to the outbox table. Note, this option requires enabling
+// the "sql/outbox" codegen feature.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Outbox(),
+//		}
+//	}
+func Outbox() *Annotation {
+	return &Annotation{Outbox: true}
+}
+
+// Tenant defines the field that holds the tenant of the schema rows.
+// Queries are filtered by the tenant that is stored in the context, and
+// mutations are restricted to it. Note, this option requires enabling
+// the "sql/tenancy" codegen feature.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Tenant("tenant_id"),
+//		}
+//	}
+func Tenant(field string) *Annotation {
+	return &Annotation{Tenant: field}
+}
+
 // View specifies the definition of a view.
 func View(as string) *Annotation {
 	return &Annotation{ViewAs: as}
@@ -461,6 +528,15 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.Skip {
 		a.Skip = true
 	}
+	if ant.History {
+		a.History = true
+	}
+	if ant.Outbox {
+		a.Outbox = true
+	}
+	if t := ant.Tenant; t != "" {
+		a.Tenant = t
+	}
 	if v := ant.ViewAs; v != "" {
 		a.ViewAs = v
 	}
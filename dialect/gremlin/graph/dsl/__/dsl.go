@@ -72,4 +72,10 @@ func Drop() *dsl.Traversal { return New().Drop() }
 // Fold is the api for calling __.Fold().
 func Fold() *dsl.Traversal { return New().Fold() }
 
+// Both is the api for calling __.Both().
+func Both(args ...any) *dsl.Traversal { return New().Both(args...) }
+
+// HasID is the api for calling __.HasID().
+func HasID(args ...any) *dsl.Traversal { return New().HasID(args...) }
+
 func New() *dsl.Traversal { return new(dsl.Traversal).Add(dsl.Token("__")) }
@@ -226,6 +226,11 @@ func TestTraverse(t *testing.T) {
 			wantQuery: "g.V().has($0).sideEffect(__.properties($1).drop()).valueMap()",
 			wantBinds: dsl.Bindings{"$0": "age", "$1": "name"},
 		},
+		{
+			input:     g.V(1).Repeat(__.Union(__.Out("friends"), __.Both("spouse")).SimplePath()).Until(__.HasID(2)).Limit(1).Path().Unfold().ID(),
+			wantQuery: "g.V($0).repeat(__.union(__.out($1), __.both($2)).simplePath()).until(__.hasId($3)).limit($4).path().unfold().id()",
+			wantBinds: dsl.Bindings{"$0": 1, "$1": "friends", "$2": "spouse", "$3": 2, "$4": 1},
+		},
 	}
 	for i, tt := range tests {
 		tt := tt
@@ -363,6 +363,26 @@ func (t *Traversal) Union(args ...any) *Traversal {
 	return t.Add(Dot, NewFunc("union", args...))
 }
 
+// Repeat loops over the given traversal until the modulating until() step is satisfied.
+func (t *Traversal) Repeat(args ...any) *Traversal {
+	return t.Add(Dot, NewFunc("repeat", args...))
+}
+
+// Until modulates the repeat() step by the given break predicate or traversal.
+func (t *Traversal) Until(args ...any) *Traversal {
+	return t.Add(Dot, NewFunc("until", args...))
+}
+
+// SimplePath filters out objects whose path contains repeated objects.
+func (t *Traversal) SimplePath() *Traversal {
+	return t.Add(Dot, NewFunc("simplePath"))
+}
+
+// Path maps the traverser to the history of objects it has visited.
+func (t *Traversal) Path() *Traversal {
+	return t.Add(Dot, NewFunc("path"))
+}
+
 // SideEffect allows the traverser to proceed unchanged, but yield some computational
 // sideEffect in the process.
 func (t *Traversal) SideEffect(args ...any) *Traversal {
@@ -690,9 +690,10 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 // DeleteBuilder is a builder for `DELETE` statement.
 type DeleteBuilder struct {
 	Builder
-	table  string
-	schema string
-	where  *Predicate
+	table     string
+	schema    string
+	where     *Predicate
+	returning []string
 }
 
 // Delete creates a builder for the `DELETE` statement.
@@ -735,6 +736,13 @@ func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
 	return d
 }
 
+// Returning adds the `RETURNING` clause to the delete statement.
+// Supported by SQLite and PostgreSQL.
+func (d *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	d.returning = columns
+	return d
+}
+
 // Query returns query representation of a `DELETE` statement.
 func (d *DeleteBuilder) Query() (string, []any) {
 	d.WriteString("DELETE FROM ")
@@ -744,6 +752,7 @@ func (d *DeleteBuilder) Query() (string, []any) {
 		d.WriteString(" WHERE ")
 		d.Join(d.where)
 	}
+	joinReturning(d.returning, &d.Builder)
 	return d.String(), d.args
 }
 
@@ -805,6 +814,35 @@ func Not(pred *Predicate) *Predicate {
 	})
 }
 
+// Sensitive wraps the arguments of the given predicate with dialect.Sensitive, in order to
+// redact them from the logs of the debug driver.
+//
+//	Sensitive(EQ("password", "pass"))
+func Sensitive(pred *Predicate) *Predicate {
+	return P(func(b *Builder) {
+		b.Join(sensitivePredicate{pred})
+	})
+}
+
+// sensitivePredicate is a Querier that wraps the arguments of its predicate.
+type sensitivePredicate struct {
+	*Predicate
+}
+
+// Query returns the query of the predicate with its arguments wrapped.
+func (p sensitivePredicate) Query() (string, []any) {
+	query, args := p.Predicate.Query()
+	wrapped := make([]any, len(args))
+	for i, a := range args {
+		if _, ok := a.(dialect.Sensitive); ok {
+			wrapped[i] = a
+		} else {
+			wrapped[i] = dialect.Sensitive{V: a}
+		}
+	}
+	return query, wrapped
+}
+
 // Not appends NOT to the predicate.
 func (p *Predicate) Not() *Predicate {
 	return p.Append(func(b *Builder) {
@@ -1770,6 +1808,16 @@ func (s *Selector) AppendSelect(columns ...string) *Selector {
 	return s
 }
 
+// PrependSelect prepends additional columns to the SELECT statement.
+func (s *Selector) PrependSelect(columns ...string) *Selector {
+	prepend := make([]selection, len(columns), len(columns)+len(s.selection))
+	for i := range columns {
+		prepend[i] = selection{c: columns[i]}
+	}
+	s.selection = append(prepend, s.selection...)
+	return s
+}
+
 // AppendSelectAs appends additional column to the SELECT statement with the given alias.
 func (s *Selector) AppendSelectAs(column, as string) *Selector {
 	s.selection = append(s.selection, selection{c: column, as: as})
@@ -546,6 +546,27 @@ func TestBuilder(t *testing.T) {
 				Schema("mydb"),
 			wantQuery: `DELETE FROM "mydb"."users" WHERE "parent_id" IS NULL`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id", "name"),
+			wantQuery: `DELETE FROM "users" WHERE "parent_id" IS NULL RETURNING "id", "name"`,
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("*"),
+			wantQuery: "DELETE FROM `users` WHERE `parent_id` IS NULL RETURNING *",
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id"),
+			wantQuery: "DELETE FROM `users` WHERE `parent_id` IS NULL",
+		},
 		{
 			input: Delete("users").
 				Where(And(IsNull("parent_id"), NotIn("name", "foo", "bar"))),
@@ -2315,6 +2336,16 @@ func TestSelector_SelectedColumn(t *testing.T) {
 	})
 }
 
+func TestSelector_PrependSelect(t *testing.T) {
+	s := Select().From(Table("users"))
+	s.Select(s.C("name"))
+	s.AppendSelectExprAs(Expr("COUNT(*)"), "count")
+	s.PrependSelect(s.C("id"))
+	query, args := s.Query()
+	require.Equal(t, "SELECT `users`.`id`, `users`.`name`, (COUNT(*)) AS `count` FROM `users`", query)
+	require.Empty(t, args)
+}
+
 func TestColumnsHasPrefix(t *testing.T) {
 	t.Run("MySQL", func(t *testing.T) {
 		query, args := Dialect(dialect.MySQL).
@@ -0,0 +1,211 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"entgo.io/ent/dialect"
+)
+
+// ReplicaDriver is a dialect.Driver implementation that routes read queries
+// to a set of replicas, and all other statements and transactions to the primary.
+type ReplicaDriver struct {
+	primary  *Driver
+	replicas []*Driver
+	// weights holds the cumulative weights of the replicas.
+	weights []int
+	next    atomic.Uint64
+	onRoute func(context.Context, Route)
+}
+
+// Route describes the database that was selected for executing a statement.
+type Route struct {
+	// Query is the executed statement.
+	Query string
+	// Replica holds the index of the replica that was selected for
+	// executing the statement, or -1 if it was executed on the primary.
+	Replica int
+}
+
+// Primary reports if the statement was executed on the primary.
+func (r Route) Primary() bool {
+	return r.Replica == -1
+}
+
+// ReplicaOption allows configuring the ReplicaDriver.
+type ReplicaOption func(*ReplicaDriver) error
+
+// ReplicaWeights sets the weights of the replicas for the weighted round-robin
+// selection. The weights are positive and ordered by the replicas. By default,
+// all replicas have the same weight.
+func ReplicaWeights(weights ...int) ReplicaOption {
+	return func(d *ReplicaDriver) error {
+		if len(weights) != len(d.replicas) {
+			return fmt.Errorf("sql: expect %d replica weights, got %d", len(d.replicas), len(weights))
+		}
+		for i, w := range weights {
+			if w <= 0 {
+				return fmt.Errorf("sql: invalid weight %d for replica %d", w, i)
+			}
+			d.weights[i] = w
+			if i > 0 {
+				d.weights[i] += d.weights[i-1]
+			}
+		}
+		return nil
+	}
+}
+
+// ReplicaOnRoute sets a function that is called with the route of every
+// statement before it is executed. It can be used for logging and tracing
+// the database that served a query.
+func ReplicaOnRoute(f func(context.Context, Route)) ReplicaOption {
+	return func(d *ReplicaDriver) error {
+		d.onRoute = f
+		return nil
+	}
+}
+
+// NewReplicaDriver returns a new ReplicaDriver for the given primary and replicas.
+//
+//	drv, err := sql.NewReplicaDriver(primary, []*sql.Driver{replica1, replica2})
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(drv))
+func NewReplicaDriver(primary *Driver, replicas []*Driver, opts ...ReplicaOption) (*ReplicaDriver, error) {
+	if primary == nil {
+		return nil, errors.New("sql: missing primary driver")
+	}
+	d := &ReplicaDriver{
+		primary:  primary,
+		replicas: replicas,
+		weights:  make([]int, len(replicas)),
+	}
+	for i, r := range replicas {
+		if r == nil {
+			return nil, fmt.Errorf("sql: replica %d is nil", i)
+		}
+		if r.Dialect() != primary.Dialect() {
+			return nil, fmt.Errorf("sql: replica %d dialect %q does not match the primary dialect %q", i, r.Dialect(), primary.Dialect())
+		}
+		d.weights[i] = i + 1
+	}
+	for _, opt := range opts {
+		if err := opt(d); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// primaryKey is the context key for forcing the primary.
+type primaryKey struct{}
+
+// WithPrimary returns a new context that routes all statements that are
+// executed with it to the primary database. It is useful for reading the
+// writes that were not replicated yet (read-your-writes).
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// Primary returns the primary driver.
+func (d *ReplicaDriver) Primary() *Driver {
+	return d.primary
+}
+
+// Replicas returns the replica drivers.
+func (d *ReplicaDriver) Replicas() []*Driver {
+	return d.replicas
+}
+
+// Exec implements the dialect.Exec method. Statements are executed on the primary.
+func (d *ReplicaDriver) Exec(ctx context.Context, query string, args, v any) error {
+	d.route(ctx, query, -1)
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method. Read queries are executed on one of
+// the replicas, unless the context was created using WithPrimary. Locking reads and
+// statements that modify data (e.g., INSERT ... RETURNING) are executed on the primary.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v any) error {
+	if len(d.replicas) == 0 || !readQuery(query) {
+		d.route(ctx, query, -1)
+		return d.primary.Query(ctx, query, args, v)
+	}
+	if p, _ := ctx.Value(primaryKey{}).(bool); p {
+		d.route(ctx, query, -1)
+		return d.primary.Query(ctx, query, args, v)
+	}
+	i := d.pick()
+	d.route(ctx, query, i)
+	return d.replicas[i].Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction on the primary.
+func (d *ReplicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.primary.Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on the primary.
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	return d.primary.BeginTx(ctx, opts)
+}
+
+// Close closes the primary and the replicas connections.
+func (d *ReplicaDriver) Close() error {
+	errs := []error{d.primary.Close()}
+	for _, r := range d.replicas {
+		errs = append(errs, r.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Dialect implements the dialect.Dialect method.
+func (d *ReplicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
+
+// pick returns the index of the next replica using weighted round-robin.
+func (d *ReplicaDriver) pick() int {
+	total := d.weights[len(d.weights)-1]
+	n := int((d.next.Add(1) - 1) % uint64(total))
+	return sort.SearchInts(d.weights, n+1)
+}
+
+func (d *ReplicaDriver) route(ctx context.Context, query string, replica int) {
+	if d.onRoute != nil {
+		d.onRoute(ctx, Route{Query: query, Replica: replica})
+	}
+}
+
+// readQuery reports if the given query is a read that can be executed on a replica.
+func readQuery(query string) bool {
+	q := strings.ToUpper(strings.TrimSpace(query))
+	switch {
+	case strings.HasPrefix(q, "SELECT"):
+	case strings.HasPrefix(q, "WITH"):
+		// Data-modifying statements in WITH (PostgreSQL).
+		for _, kw := range []string{"INSERT INTO", "UPDATE ", "DELETE FROM"} {
+			if strings.Contains(q, kw) {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+	for _, kw := range []string{" FOR UPDATE", " FOR SHARE", " FOR NO KEY UPDATE", " FOR KEY SHARE", " LOCK IN SHARE MODE"} {
+		if strings.Contains(q, kw) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaDriver(t *testing.T) {
+	var (
+		mocks   []sqlmock.Sqlmock
+		drivers []*Driver
+	)
+	for range 3 {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mocks = append(mocks, mock)
+		drivers = append(drivers, OpenDB(dialect.Postgres, db))
+	}
+	var routes []Route
+	drv, err := NewReplicaDriver(drivers[0], drivers[1:], ReplicaOnRoute(func(_ context.Context, r Route) {
+		routes = append(routes, r)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, dialect.Postgres, drv.Dialect())
+	ctx := context.Background()
+
+	// Reads are distributed between the replicas.
+	for _, i := range []int{1, 2, 1} {
+		mocks[i].ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+		rows := &Rows{}
+		require.NoError(t, drv.Query(ctx, "SELECT 1", []any{}, rows))
+		require.NoError(t, rows.Close())
+	}
+	require.Equal(t, []Route{{"SELECT 1", 0}, {"SELECT 1", 1}, {"SELECT 1", 0}}, routes)
+
+	// Writes, locking reads and forced reads are executed on the primary.
+	mocks[0].ExpectExec("UPDATE users").WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "UPDATE users SET name = $1", []any{"a8m"}, nil))
+	for _, q := range []string{"INSERT INTO users DEFAULT VALUES RETURNING id", "SELECT id FROM users FOR UPDATE"} {
+		mocks[0].ExpectQuery(q).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		rows := &Rows{}
+		require.NoError(t, drv.Query(ctx, q, []any{}, rows))
+		require.NoError(t, rows.Close())
+	}
+	mocks[0].ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(WithPrimary(ctx), "SELECT 1", []any{}, rows))
+	require.NoError(t, rows.Close())
+	require.True(t, routes[len(routes)-1].Primary())
+
+	// Transactions are executed on the primary.
+	mocks[0].ExpectBegin()
+	mocks[0].ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mocks[0].ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "SELECT 1", []any{}, rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, tx.Commit())
+
+	for _, m := range mocks {
+		require.NoError(t, m.ExpectationsWereMet())
+		m.ExpectClose()
+	}
+	require.NoError(t, drv.Close())
+}
+
+func TestReplicaDriver_Weights(t *testing.T) {
+	var drivers []*Driver
+	for range 3 {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		drivers = append(drivers, OpenDB(dialect.MySQL, db))
+	}
+	drv, err := NewReplicaDriver(drivers[0], drivers[1:], ReplicaWeights(3, 1))
+	require.NoError(t, err)
+	var picks []int
+	for range 8 {
+		picks = append(picks, drv.pick())
+	}
+	require.Equal(t, []int{0, 0, 0, 1, 0, 0, 0, 1}, picks)
+
+	_, err = NewReplicaDriver(drivers[0], drivers[1:], ReplicaWeights(1))
+	require.Error(t, err)
+	_, err = NewReplicaDriver(drivers[0], drivers[1:], ReplicaWeights(1, 0))
+	require.Error(t, err)
+	_, err = NewReplicaDriver(drivers[0], []*Driver{OpenDB(dialect.Postgres, drivers[1].DB())})
+	require.Error(t, err)
+}
@@ -972,6 +972,13 @@ func (a *Atlas) aColumns(et *Table, at *schema.Table) error {
 		if err := a.atDefault(c1, c2); err != nil {
 			return err
 		}
+		if g := c1.Generated; g != nil {
+			x := &schema.GeneratedExpr{Expr: g.Expr, Type: "VIRTUAL"}
+			if g.Stored {
+				x.Type = "STORED"
+			}
+			c2.SetGeneratedExpr(x)
+		}
 		if c1.Unique && (len(et.PrimaryKey) != 1 || et.PrimaryKey[0] != c1) {
 			a.sqlDialect.atUniqueC(et, c1, at, c2)
 		}
@@ -446,6 +446,28 @@ func TestAtlas_StateReader(t *testing.T) {
 	)
 }
 
+func TestAtlas_GeneratedColumns(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:test?mode=memory&_fk=1")
+	require.NoError(t, err)
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	realm, err := m.StateReader(&Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt64, Increment: true},
+			{Name: "first", Type: field.TypeString},
+			{Name: "last", Type: field.TypeString},
+			{Name: "full", Type: field.TypeString, Generated: &field.Generated{Expr: "first || ' ' || last", Stored: true}},
+			{Name: "initial", Type: field.TypeString, Generated: &field.Generated{Expr: "substr(first, 1, 1)"}},
+		},
+	}).ReadState(context.Background())
+	require.NoError(t, err)
+	columns := realm.Schemas[0].Tables[0].Columns
+	require.Len(t, columns, 5)
+	require.Equal(t, []schema.Attr{&schema.GeneratedExpr{Expr: "first || ' ' || last", Type: "STORED"}}, columns[3].Attrs)
+	require.Equal(t, []schema.Attr{&schema.GeneratedExpr{Expr: "substr(first, 1, 1)", Type: "VIRTUAL"}}, columns[4].Attrs)
+}
+
 func TestAtlas_ParallelCreate(t *testing.T) {
 	var wg sync.WaitGroup
 	wg.Add(10)
@@ -310,6 +310,7 @@ type Column struct {
 	indexes    Indexes           // linked indexes.
 	foreign    *ForeignKey       // linked foreign-key.
 	Comment    string            // optional column comment.
+	Generated  *field.Generated  // generated column expression.
 }
 
 // Expr represents a raw expression. It is used to distinguish between
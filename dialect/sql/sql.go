@@ -191,6 +191,58 @@ func FieldContainsFold(name string, substr string) func(*Selector) {
 	}
 }
 
+// ExprOp returns a raw predicate to compare the given expression with the given values using
+// the given operator. It is used by the generated code for computed fields. For example:
+//
+//	ExprOp(func(s *Selector) Querier {
+//		return Expr("LENGTH(" + s.C("name") + ")")
+//	}, OpGT, 10)
+func ExprOp[T any](x func(*Selector) Querier, op Op, vs ...T) func(*Selector) {
+	return func(s *Selector) {
+		// Similar to the In and NotIn predicates, we cannot apply "IN ()".
+		if len(vs) == 0 && (op == OpIn || op == OpNotIn) {
+			if op == OpIn {
+				s.Where(False())
+			}
+			return
+		}
+		s.Where(P(func(b *Builder) {
+			b.Wrap(func(b *Builder) {
+				b.Join(x(s))
+			})
+			b.WriteOp(op)
+			switch op {
+			case OpIsNull, OpNotNull:
+			case OpIn, OpNotIn:
+				b.Wrap(func(b *Builder) {
+					for i := range vs {
+						if i > 0 {
+							b.Comma()
+						}
+						b.Arg(vs[i])
+					}
+				})
+			default:
+				b.Arg(vs[0])
+			}
+		}))
+	}
+}
+
+// SensitivePredicate returns a new predicate that redacts the arguments of the given generated
+// predicate from the logs of the debug driver. It is used by the generated code for sensitive fields.
+func SensitivePredicate[P ~func(*Selector)](pred P) func(*Selector) {
+	return func(s *Selector) {
+		s.CollectPredicates()
+		pred(s)
+		collected := s.CollectedPredicates()
+		s.UncollectedPredicates()
+		for _, p := range collected {
+			s.Where(Sensitive(p))
+		}
+	}
+}
+
 // AndPredicates returns a new predicate for joining multiple generated predicates with AND between them.
 func AndPredicates[P ~func(*Selector)](predicates ...P) func(*Selector) {
 	return func(s *Selector) {
@@ -392,6 +444,11 @@ func orderByAgg(fn, field string, opts ...OrderTermOption) *OrderExprTerm {
 	}
 }
 
+// OrderByExpr returns an ordering by the given expression.
+func OrderByExpr(x func(*Selector) Querier, opts ...OrderTermOption) *OrderExprTerm {
+	return &OrderExprTerm{Expr: x, OrderTermOptions: *NewOrderTermOptions(opts...)}
+}
+
 // OrderByRand returns a term to natively order by a random value.
 func OrderByRand() func(*Selector) {
 	return func(s *Selector) {
@@ -424,5 +481,25 @@ func (f *OrderFieldTerm) ToFunc() func(*Selector) {
 	}
 }
 
+// ToFunc returns a function that sets the ordering on the given selector.
+// This is used by the generated code.
+func (f *OrderExprTerm) ToFunc() func(*Selector) {
+	return func(s *Selector) {
+		s.OrderExprFunc(func(b *Builder) {
+			b.Wrap(func(b *Builder) {
+				b.Join(f.Expr(s))
+			})
+			if f.Desc {
+				b.WriteString(" DESC")
+			}
+			if f.NullsFirst {
+				b.WriteString(" NULLS FIRST")
+			} else if f.NullsLast {
+				b.WriteString(" NULLS LAST")
+			}
+		})
+	}
+}
+
 func (OrderFieldTerm) term() {}
 func (OrderExprTerm) term()  {}
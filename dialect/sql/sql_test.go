@@ -5,6 +5,7 @@
 package sql
 
 import (
+	"fmt"
 	"testing"
 
 	"entgo.io/ent/dialect"
@@ -426,6 +427,82 @@ func TestFieldContainsFold(t *testing.T) {
 	})
 }
 
+func TestExprOp(t *testing.T) {
+	length := func(s *Selector) Querier {
+		return Expr("LENGTH(" + s.C("name") + ")")
+	}
+	t.Run("MySQL", func(t *testing.T) {
+		s := Dialect(dialect.MySQL).Select("*").From(Table("users"))
+		ExprOp(length, OpGT, 10)(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `users` WHERE (LENGTH(`users`.`name`)) > ?", query)
+		require.Equal(t, []any{10}, args)
+	})
+	t.Run("PostgreSQL", func(t *testing.T) {
+		s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+		ExprOp(length, OpIn, 1, 2)(s)
+		ExprOp[int](length, OpNotNull)(s)
+		query, args := s.Query()
+		require.Equal(t, `SELECT * FROM "users" WHERE (LENGTH("users"."name")) IN ($1, $2) AND (LENGTH("users"."name")) IS NOT NULL`, query)
+		require.Equal(t, []any{1, 2}, args)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		s := Dialect(dialect.MySQL).Select("*").From(Table("users"))
+		ExprOp[int](length, OpNotIn)(s)
+		ExprOp[int](length, OpIn)(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `users` WHERE FALSE", query)
+		require.Empty(t, args)
+	})
+}
+
+func TestSensitivePredicate(t *testing.T) {
+	s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+	FieldEQ("name", "a8m")(s)
+	SensitivePredicate(FieldHasPrefix("password", "hunter"))(s)
+	SensitivePredicate(FieldIn("token", "a", "b"))(s)
+	query, args := s.Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE ("users"."name" = $1 AND "users"."password" LIKE $2) AND "users"."token" IN ($3, $4)`, query)
+	require.Equal(t, []any{"a8m", dialect.Sensitive{V: "hunter%"}, dialect.Sensitive{V: "a"}, dialect.Sensitive{V: "b"}}, args)
+	require.Equal(t, "[a8m <sensitive> <sensitive> <sensitive>]", fmt.Sprint(args))
+	require.Equal(t, "<sensitive> <sensitive>", fmt.Sprintf("%#v %q", args[1], args[1]))
+	v, err := args[1].(dialect.Sensitive).Value()
+	require.NoError(t, err)
+	require.Equal(t, "hunter%", v)
+
+	// Values are converted to basic types only when possible, and
+	// driver.Valuer implementations and native types are left as-is.
+	v, err = dialect.Sensitive{V: int32(1)}.Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	v, err = dialect.Sensitive{V: NullString{String: "a8m", Valid: true}}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "a8m", v)
+	v, err = dialect.Sensitive{V: []string{"a", "b"}}.Value()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, v)
+	v, err = dialect.Sensitive{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	// Predicates are collected by the outer builder.
+	s = Dialect(dialect.MySQL).Select("*").From(Table("users"))
+	OrPredicates(SensitivePredicate(FieldEQ("password", "p1")), FieldEQ("name", "a8m"))(s)
+	query, args = s.Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `users`.`password` = ? OR `users`.`name` = ?", query)
+	require.Equal(t, []any{dialect.Sensitive{V: "p1"}, "a8m"}, args)
+}
+
+func TestOrderByExpr(t *testing.T) {
+	s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+	OrderByExpr(func(s *Selector) Querier {
+		return Expr("LENGTH(" + s.C("name") + ")")
+	}, OrderDesc(), OrderNullsLast()).ToFunc()(s)
+	query, args := s.Query()
+	require.Equal(t, `SELECT * FROM "users" ORDER BY (LENGTH("users"."name")) DESC NULLS LAST`, query)
+	require.Empty(t, args)
+}
+
 func TestAndPredicates(t *testing.T) {
 	s := Select("*").From(Table("users")).Where(EQ("name", "a8m"))
 	p := AndPredicates(
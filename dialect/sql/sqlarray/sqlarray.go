@@ -0,0 +1,463 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlarray provides predicates and update operations for array columns.
+// Array columns are stored as native arrays in PostgreSQL (e.g. text[] or bigint[]),
+// and as JSON arrays in other dialects.
+package sqlarray
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Contains returns a predicate for checking that the array
+// column contains all the given values.
+//
+//	sqlarray.Contains("tags", []string{"a", "b"})
+func Contains[T any](column string, vs []T) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" @> ").Arg(literal(vs))
+		case dialect.MySQL:
+			b.WriteString("JSON_CONTAINS").Wrap(func(b *sql.Builder) {
+				b.Ident(column).Comma().Arg(marshal(vs))
+			})
+		default:
+			if len(vs) == 0 {
+				b.WriteString("TRUE")
+				return
+			}
+			for i, v := range vs {
+				if i > 0 {
+					b.WriteString(" AND ")
+				}
+				b.WriteString("EXISTS").Wrap(func(b *sql.Builder) {
+					eachFrom(b, column).WriteString(" WHERE ").Ident("e").WriteByte('.').Ident("value").WriteOp(sql.OpEQ).Arg(v)
+				})
+			}
+		}
+	})
+}
+
+// ContainedBy returns a predicate for checking that all
+// values of the array column exist in the given values.
+//
+//	sqlarray.ContainedBy("tags", []string{"a", "b"})
+func ContainedBy[T any](column string, vs []T) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" <@ ").Arg(literal(vs))
+		case dialect.MySQL:
+			b.WriteString("JSON_CONTAINS").Wrap(func(b *sql.Builder) {
+				b.Arg(marshal(vs)).Comma().Ident(column)
+			})
+		default:
+			b.WriteString("NOT EXISTS").Wrap(func(b *sql.Builder) {
+				eachFrom(b, column)
+				if len(vs) > 0 {
+					b.WriteString(" WHERE ").Ident("e").WriteByte('.').Ident("value").WriteOp(sql.OpNotIn).Wrap(func(b *sql.Builder) {
+						b.Args(anys(vs)...)
+					})
+				}
+			})
+		}
+	})
+}
+
+// Overlaps returns a predicate for checking that the array
+// column contains at least one of the given values.
+//
+//	sqlarray.Overlaps("tags", []string{"a", "b"})
+func Overlaps[T any](column string, vs []T) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" && ").Arg(literal(vs))
+		case dialect.MySQL:
+			b.WriteString("JSON_OVERLAPS").Wrap(func(b *sql.Builder) {
+				b.Ident(column).Comma().Arg(marshal(vs))
+			})
+		default:
+			if len(vs) == 0 {
+				b.WriteString("FALSE")
+				return
+			}
+			b.WriteString("EXISTS").Wrap(func(b *sql.Builder) {
+				eachFrom(b, column).WriteString(" WHERE ").Ident("e").WriteByte('.').Ident("value").WriteOp(sql.OpIn).Wrap(func(b *sql.Builder) {
+					b.Args(anys(vs)...)
+				})
+			})
+		}
+	})
+}
+
+// LenEQ returns a predicate for checking that the length of
+// the array column is equal to the given argument.
+//
+//	sqlarray.LenEQ("tags", 2)
+func LenEQ(column string, size int) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.WriteString("CARDINALITY").Wrap(func(b *sql.Builder) {
+				b.Ident(column)
+			})
+		case dialect.MySQL:
+			b.WriteString("JSON_LENGTH").Wrap(func(b *sql.Builder) {
+				b.Ident(column)
+			})
+		default:
+			b.WriteString("JSON_ARRAY_LENGTH").Wrap(func(b *sql.Builder) {
+				b.Ident(column)
+			})
+		}
+		b.WriteOp(sql.OpEQ).Arg(size)
+	})
+}
+
+// FieldContains returns a raw predicate to check if the array field contains all the given values.
+func FieldContains[T any](name string, vs ...T) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(Contains(s.C(name), vs))
+	}
+}
+
+// FieldContainedBy returns a raw predicate to check if all values of the array field exist in the given values.
+func FieldContainedBy[T any](name string, vs ...T) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(ContainedBy(s.C(name), vs))
+	}
+}
+
+// FieldOverlaps returns a raw predicate to check if the array field contains at least one of the given values.
+func FieldOverlaps[T any](name string, vs ...T) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(Overlaps(s.C(name), vs))
+	}
+}
+
+// FieldLenEQ returns a raw predicate to check if the length of the array field is equal to the given size.
+func FieldLenEQ(name string, size int) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(LenEQ(s.C(name), size))
+	}
+}
+
+// Append writes to the given SQL builder the SQL command for appending
+// values to the array column. NULL columns are treated as empty arrays.
+//
+//	sqlarray.Append(u, "tags", []string{"a", "b"})
+//	UPDATE "t" SET "tags" = ARRAY_CAT(COALESCE("tags", '{}'), $1)
+func Append[T any](u *sql.UpdateBuilder, column string, elems []T) {
+	Update(u, column, nil, elems)
+}
+
+// Remove writes to the given SQL builder the SQL command for removing
+// all occurrences of the given values from the array column.
+//
+//	sqlarray.Remove(u, "tags", []string{"a", "b"})
+func Remove[T any](u *sql.UpdateBuilder, column string, elems []T) {
+	Update(u, column, elems, nil)
+}
+
+// Update writes to the given SQL builder the SQL command for removing the
+// given values from the array column, and then appending the other values
+// to it. It allows combining the two operations on the same column, as a
+// column can be set only once in an UPDATE statement.
+func Update[T any](u *sql.UpdateBuilder, column string, removed, appended []T) {
+	if len(removed) == 0 && len(appended) == 0 {
+		u.AddError(fmt.Errorf("sqlarray: missing values to append or remove from column %q", column))
+		return
+	}
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			update := func(b *sql.Builder) {
+				if len(removed) == 0 {
+					b.WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+						b.Ident(column).Comma().WriteString("'{}'")
+					})
+					return
+				}
+				b.WriteString("ARRAY").Wrap(func(b *sql.Builder) {
+					b.WriteString("SELECT ").Ident("e").WriteByte('.').Ident("v").WriteString(" FROM UNNEST").Wrap(func(b *sql.Builder) {
+						b.Ident(column)
+					})
+					b.WriteString(" WITH ORDINALITY AS ").Ident("e").WriteString("(").Ident("v").Comma().Ident("i").WriteString(")")
+					b.WriteString(" WHERE NOT (").Ident("e").WriteByte('.').Ident("v").WriteString(" = ANY").Wrap(func(b *sql.Builder) {
+						b.Arg(literal(removed))
+					})
+					b.WriteString(") ORDER BY ").Ident("e").WriteByte('.').Ident("i")
+				})
+			}
+			if len(appended) == 0 {
+				update(b)
+				return
+			}
+			b.WriteString("ARRAY_CAT").Wrap(func(b *sql.Builder) {
+				update(b)
+				b.Comma().Arg(literal(appended))
+			})
+		case dialect.MySQL:
+			update := func(b *sql.Builder) {
+				if len(removed) == 0 {
+					b.WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+						b.Ident(column).Comma().WriteString("JSON_ARRAY()")
+					})
+					return
+				}
+				b.Wrap(func(b *sql.Builder) {
+					b.WriteString("SELECT COALESCE(JSON_ARRAYAGG(").Ident("e").WriteByte('.').Ident("v").WriteString("), JSON_ARRAY()) FROM JSON_TABLE").Wrap(func(b *sql.Builder) {
+						b.Ident(column).WriteString(", '$[*]' COLUMNS(").Ident("v").WriteString(" JSON PATH '$')")
+					})
+					b.WriteString(" AS ").Ident("e").WriteString(" WHERE NOT JSON_CONTAINS").Wrap(func(b *sql.Builder) {
+						b.Argf("CAST(? AS JSON)", marshal(removed)).Comma().Ident("e").WriteByte('.').Ident("v")
+					})
+				})
+			}
+			if len(appended) == 0 {
+				update(b)
+				return
+			}
+			b.WriteString("JSON_MERGE_PRESERVE").Wrap(func(b *sql.Builder) {
+				update(b)
+				b.Comma().Argf("CAST(? AS JSON)", marshal(appended))
+			})
+		default:
+			update := func(b *sql.Builder) {
+				if len(removed) == 0 {
+					b.WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+						b.Ident(column).Comma().WriteString("JSON_ARRAY()")
+					})
+					return
+				}
+				b.Wrap(func(b *sql.Builder) {
+					b.WriteString("SELECT JSON_GROUP_ARRAY(").Ident("e").WriteByte('.').Ident("value").WriteString(") FROM ")
+					b.WriteString("JSON_EACH").Wrap(func(b *sql.Builder) {
+						b.Ident(column)
+					})
+					b.WriteString(" AS ").Ident("e").WriteString(" WHERE ").Ident("e").WriteByte('.').Ident("value").WriteOp(sql.OpNotIn).Wrap(func(b *sql.Builder) {
+						b.Args(anys(removed)...)
+					})
+				})
+			}
+			if len(appended) == 0 {
+				update(b)
+				return
+			}
+			b.WriteString("JSON_INSERT").Wrap(func(b *sql.Builder) {
+				update(b)
+				for _, e := range appended {
+					b.Comma().WriteString("'$[#]'").Comma().Arg(e)
+				}
+			})
+		}
+	}))
+}
+
+// Value wraps the value of an array column. It is used by the sqlgraph
+// package to encode the value according to the dialect of the database.
+type Value struct{ V any }
+
+// Encode returns the driver value of the array for the given dialect. The
+// array is encoded as an array literal in PostgreSQL, and as JSON otherwise.
+func (v Value) Encode(name string) (driver.Value, error) {
+	if name == dialect.Postgres {
+		rv := reflect.ValueOf(v.V)
+		if rv.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("sqlarray: unexpected array type %T", v.V)
+		}
+		return encode(rv), nil
+	}
+	buf, err := json.Marshal(v.V)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buf), nil
+}
+
+// Unmarshal parses the array that was scanned from the database into v, which must be a
+// pointer to a slice. Arrays are decoded from either PostgreSQL array literals or JSON.
+func Unmarshal(data []byte, v any) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return json.Unmarshal(data, v)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("sqlarray: unmarshal expects a pointer to a slice, got %T", v)
+	}
+	elems, err := parse(string(data))
+	if err != nil {
+		return err
+	}
+	rv = rv.Elem()
+	vs := reflect.MakeSlice(rv.Type(), len(elems), len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		if err := set(vs.Index(i), *e); err != nil {
+			return fmt.Errorf("sqlarray: unmarshal element %d: %w", i, err)
+		}
+	}
+	rv.Set(vs)
+	return nil
+}
+
+// eachFrom writes the FROM clause of the JSON_EACH table function.
+func eachFrom(b *sql.Builder, column string) *sql.Builder {
+	b.WriteString("SELECT 1 FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+		b.Ident(column)
+	})
+	return b.WriteString(" AS ").Ident("e")
+}
+
+// literal returns the PostgreSQL array literal of the given values.
+func literal[T any](vs []T) string {
+	return encode(reflect.ValueOf(vs))
+}
+
+func marshal[T any](vs []T) string {
+	if vs == nil {
+		vs = []T{}
+	}
+	buf, _ := json.Marshal(vs)
+	return string(buf)
+}
+
+func anys[T any](vs []T) []any {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return args
+}
+
+// encode encodes the slice as a PostgreSQL array literal.
+func encode(rv reflect.Value) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		switch e := reflect.Indirect(rv.Index(i)); e.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			b.WriteString(strconv.FormatInt(e.Int(), 10))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			b.WriteString(strconv.FormatUint(e.Uint(), 10))
+		case reflect.Float32, reflect.Float64:
+			b.WriteString(strconv.FormatFloat(e.Float(), 'g', -1, 64))
+		case reflect.Bool:
+			b.WriteString(strconv.FormatBool(e.Bool()))
+		case reflect.Invalid:
+			b.WriteString("NULL")
+		default:
+			b.WriteByte('"')
+			for _, r := range fmt.Sprint(e.Interface()) {
+				if r == '"' || r == '\\' {
+					b.WriteByte('\\')
+				}
+				b.WriteRune(r)
+			}
+			b.WriteByte('"')
+		}
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// parse parses a one-dimensional PostgreSQL array literal. NULL elements are returned as nil.
+func parse(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("sqlarray: invalid array literal %q", s)
+	}
+	var (
+		elems []*string
+		body  = s[1 : len(s)-1]
+	)
+	if body == "" {
+		return elems, nil
+	}
+	for i := 0; i <= len(body); i++ {
+		var e strings.Builder
+		switch {
+		case i < len(body) && body[i] == '"':
+			for i++; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' {
+					i++
+				}
+				if i < len(body) {
+					e.WriteByte(body[i])
+				}
+			}
+			if i >= len(body) {
+				return nil, fmt.Errorf("sqlarray: unterminated quoted element in %q", s)
+			}
+			i++
+			v := e.String()
+			elems = append(elems, &v)
+		default:
+			for ; i < len(body) && body[i] != ','; i++ {
+				e.WriteByte(body[i])
+			}
+			if v := strings.TrimSpace(e.String()); strings.EqualFold(v, "NULL") {
+				elems = append(elems, nil)
+			} else {
+				elems = append(elems, &v)
+			}
+		}
+		if i < len(body) && body[i] != ',' {
+			return nil, fmt.Errorf("sqlarray: unexpected character %q in %q", body[i], s)
+		}
+	}
+	return elems, nil
+}
+
+// set assigns the string representation of an array element to v.
+func set(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported element type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlarray_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlarray"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		input     sql.Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(sqlarray.Contains("tags", []string{"a", `b"c`})),
+			wantQuery: `SELECT * FROM "users" WHERE "tags" @> $1`,
+			wantArgs:  []any{`{"a","b\"c"}`},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(sqlarray.ContainedBy("nums", []int{1, 2})),
+			wantQuery: `SELECT * FROM "users" WHERE "nums" <@ $1`,
+			wantArgs:  []any{`{1,2}`},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(sqlarray.Overlaps("nums", []float64{1.5})),
+			wantQuery: `SELECT * FROM "users" WHERE "nums" && $1`,
+			wantArgs:  []any{`{1.5}`},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(sqlarray.LenEQ("tags", 2)),
+			wantQuery: `SELECT * FROM "users" WHERE CARDINALITY("tags") = $1`,
+			wantArgs:  []any{2},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("users")).Where(sqlarray.Contains("tags", []string{"a"})),
+			wantQuery: "SELECT * FROM `users` WHERE JSON_CONTAINS(`tags`, ?)",
+			wantArgs:  []any{`["a"]`},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("users")).Where(sqlarray.ContainedBy("tags", []string{"a"})),
+			wantQuery: "SELECT * FROM `users` WHERE JSON_CONTAINS(?, `tags`)",
+			wantArgs:  []any{`["a"]`},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("users")).Where(sqlarray.Overlaps("tags", []string{"a"})),
+			wantQuery: "SELECT * FROM `users` WHERE JSON_OVERLAPS(`tags`, ?)",
+			wantArgs:  []any{`["a"]`},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("users")).Where(sqlarray.LenEQ("tags", 1)),
+			wantQuery: "SELECT * FROM `users` WHERE JSON_LENGTH(`tags`) = ?",
+			wantArgs:  []any{1},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users")).Where(sqlarray.Contains("tags", []string{"a", "b"})),
+			wantQuery: "SELECT * FROM `users` WHERE EXISTS(SELECT 1 FROM JSON_EACH(`tags`) AS `e` WHERE `e`.`value` = ?) AND EXISTS(SELECT 1 FROM JSON_EACH(`tags`) AS `e` WHERE `e`.`value` = ?)",
+			wantArgs:  []any{"a", "b"},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users")).Where(sqlarray.ContainedBy("tags", []string{"a", "b"})),
+			wantQuery: "SELECT * FROM `users` WHERE NOT EXISTS(SELECT 1 FROM JSON_EACH(`tags`) AS `e` WHERE `e`.`value` NOT IN (?, ?))",
+			wantArgs:  []any{"a", "b"},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users")).Where(sqlarray.Overlaps("tags", []string{"a"})),
+			wantQuery: "SELECT * FROM `users` WHERE EXISTS(SELECT 1 FROM JSON_EACH(`tags`) AS `e` WHERE `e`.`value` IN (?))",
+			wantArgs:  []any{"a"},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users")).Where(sqlarray.Overlaps("tags", []string{})),
+			wantQuery: "SELECT * FROM `users` WHERE FALSE",
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users")).Where(sqlarray.LenEQ("tags", 0)),
+			wantQuery: "SELECT * FROM `users` WHERE JSON_ARRAY_LENGTH(`tags`) = ?",
+			wantArgs:  []any{0},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		input     sql.Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqlarray.Append(u, "c", []string{"a", "b"})
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = ARRAY_CAT(COALESCE("c", '{}'), $1)`,
+			wantArgs:  []any{`{"a","b"}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqlarray.Remove(u, "c", []int{1})
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = ARRAY(SELECT "e"."v" FROM UNNEST("c") WITH ORDINALITY AS "e"("v", "i") WHERE NOT ("e"."v" = ANY($1)) ORDER BY "e"."i")`,
+			wantArgs:  []any{`{1}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqlarray.Update(u, "c", []int{1}, []int{2})
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = ARRAY_CAT(ARRAY(SELECT "e"."v" FROM UNNEST("c") WITH ORDINALITY AS "e"("v", "i") WHERE NOT ("e"."v" = ANY($1)) ORDER BY "e"."i"), $2)`,
+			wantArgs:  []any{`{1}`, `{2}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("t")
+				sqlarray.Update(u, "c", []string{"a"}, []string{"b"})
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_MERGE_PRESERVE((SELECT COALESCE(JSON_ARRAYAGG(`e`.`v`), JSON_ARRAY()) FROM JSON_TABLE(`c`, '$[*]' COLUMNS(`v` JSON PATH '$')) AS `e` WHERE NOT JSON_CONTAINS(CAST(? AS JSON), `e`.`v`)), CAST(? AS JSON))",
+			wantArgs:  []any{`["a"]`, `["b"]`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqlarray.Append(u, "c", []string{"a", "b"})
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_INSERT(COALESCE(`c`, JSON_ARRAY()), '$[#]', ?, '$[#]', ?)",
+			wantArgs:  []any{"a", "b"},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqlarray.Remove(u, "c", []string{"a", "b"})
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = (SELECT JSON_GROUP_ARRAY(`e`.`value`) FROM JSON_EACH(`c`) AS `e` WHERE `e`.`value` NOT IN (?, ?))",
+			wantArgs:  []any{"a", "b"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+
+	u := sql.Dialect(dialect.Postgres).Update("t")
+	sqlarray.Append(u, "c", []string{})
+	require.EqualError(t, u.Err(), `sqlarray: missing values to append or remove from column "c"`)
+}
+
+func TestValue(t *testing.T) {
+	v, err := sqlarray.Value{V: []string{"a", `b,"c"`, `d\e`}}.Encode(dialect.Postgres)
+	require.NoError(t, err)
+	require.Equal(t, `{"a","b,\"c\"","d\\e"}`, v)
+	v, err = sqlarray.Value{V: []int(nil)}.Encode(dialect.Postgres)
+	require.NoError(t, err)
+	require.Equal(t, `{}`, v)
+	v, err = sqlarray.Value{V: []int{1, 2}}.Encode(dialect.SQLite)
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`[1,2]`), v)
+	_, err = sqlarray.Value{V: 1}.Encode(dialect.Postgres)
+	require.Error(t, err)
+}
+
+func TestUnmarshal(t *testing.T) {
+	var s []string
+	require.NoError(t, sqlarray.Unmarshal([]byte(`{a,"b,\"c\"","d\\e",NULL,"NULL"}`), &s))
+	require.Equal(t, []string{"a", `b,"c"`, `d\e`, "", "NULL"}, s)
+	require.NoError(t, sqlarray.Unmarshal([]byte(`["x"]`), &s))
+	require.Equal(t, []string{"x"}, s)
+	require.NoError(t, sqlarray.Unmarshal([]byte(`{}`), &s))
+	require.Equal(t, []string{}, s)
+
+	var ints []int
+	require.NoError(t, sqlarray.Unmarshal([]byte(`{1,-2,3}`), &ints))
+	require.Equal(t, []int{1, -2, 3}, ints)
+	var floats []float64
+	require.NoError(t, sqlarray.Unmarshal([]byte(`{1.5,2}`), &floats))
+	require.Equal(t, []float64{1.5, 2}, floats)
+
+	require.Error(t, sqlarray.Unmarshal([]byte(`{"a}`), &s))
+	require.Error(t, sqlarray.Unmarshal([]byte(`{a}`), &ints))
+	require.Error(t, sqlarray.Unmarshal([]byte(`{1}`), ints))
+}
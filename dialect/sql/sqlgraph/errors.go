@@ -6,6 +6,7 @@ package sqlgraph
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -18,6 +19,114 @@ func IsConstraintError(err error) bool {
 		IsCheckConstraintError(err)
 }
 
+// ConstraintKind describes the kind of a database constraint.
+type ConstraintKind uint
+
+// List of constraint kinds.
+const (
+	_ ConstraintKind = iota
+	UniqueConstraint
+	ForeignKeyConstraint
+	CheckConstraint
+)
+
+// String returns the constraint kind name.
+func (k ConstraintKind) String() string {
+	var s string
+	switch k {
+	case UniqueConstraint:
+		s = "unique"
+	case ForeignKeyConstraint:
+		s = "foreign key"
+	case CheckConstraint:
+		s = "check"
+	default:
+		s = "unknown"
+	}
+	return s
+}
+
+// ParseConstraintError returns a ConstraintError that describes the constraint that was violated
+// by the given error, or nil if the error did not result from a database constraint violation.
+// The name, table and columns of the constraint are parsed from the MySQL, PostgreSQL and SQLite
+// error messages, and are left empty if they are not reported by the database.
+func ParseConstraintError(err error) *ConstraintError {
+	var e *ConstraintError
+	if errors.As(err, &e) {
+		return e
+	}
+	switch {
+	case IsUniqueConstraintError(err):
+		e = &ConstraintError{Kind: UniqueConstraint}
+	case IsForeignKeyConstraintError(err):
+		e = &ConstraintError{Kind: ForeignKeyConstraint}
+	case IsCheckConstraintError(err):
+		e = &ConstraintError{Kind: CheckConstraint}
+	default:
+		return nil
+	}
+	e.msg, e.wrap = err.Error(), err
+	for _, p := range constraintParsers[e.Kind] {
+		if m := p.FindStringSubmatch(e.msg); m != nil {
+			for i, name := range p.SubexpNames() {
+				switch v := m[i]; {
+				case v == "":
+				case name == "name":
+					e.Name = v
+				case name == "table":
+					e.Table = v
+				case name == "columns":
+					e.Columns = parseColumns(v)
+				}
+			}
+			break
+		}
+	}
+	return e
+}
+
+// constraintParsers holds the patterns for extracting the
+// constraint information from the database error messages.
+var constraintParsers = map[ConstraintKind][]*regexp.Regexp{
+	UniqueConstraint: {
+		// MySQL: Duplicate entry 'a8m' for key 'users.name'. Prior to v8, the table is omitted.
+		regexp.MustCompile("Error 1062.*for key '(?:(?P<table>[^'.]+)\\.)?(?P<name>[^']+)'"),
+		// Postgres: duplicate key value violates unique constraint "users_name_key".
+		regexp.MustCompile(`violates unique constraint "(?P<name>[^"]+)"`),
+		// SQLite: UNIQUE constraint failed: users.first, users.last.
+		regexp.MustCompile(`UNIQUE constraint failed: (?P<columns>(?P<table>\w+)\.\w+(?:, \w+\.\w+)*)`),
+	},
+	ForeignKeyConstraint: {
+		// MySQL: a foreign key constraint fails (`db`.`pets`, CONSTRAINT `pets_users_pets` FOREIGN KEY (`user_pets`) ...
+		regexp.MustCompile("Error 145[12].*\\(`[^`]+`\\.`(?P<table>[^`]+)`, CONSTRAINT `(?P<name>[^`]+)` FOREIGN KEY \\((?P<columns>[^)]+)\\)"),
+		// Postgres: update or delete on table "users" violates foreign key constraint "pets_users_pets" on table "pets".
+		regexp.MustCompile(`violates foreign key constraint "(?P<name>[^"]+)" on table "(?P<table>[^"]+)"`),
+		// Postgres: insert or update on table "pets" violates foreign key constraint "pets_users_pets".
+		regexp.MustCompile(`on table "(?P<table>[^"]+)" violates foreign key constraint "(?P<name>[^"]+)"`),
+	},
+	CheckConstraint: {
+		// MySQL: Check constraint 'users_age_check' is violated.
+		regexp.MustCompile(`Error 3819.*Check constraint '(?P<name>[^']+)'`),
+		// Postgres: new row for relation "users" violates check constraint "users_age_check".
+		regexp.MustCompile(`relation "(?P<table>[^"]+)" violates check constraint "(?P<name>[^"]+)"`),
+		// SQLite: CHECK constraint failed: users_age_check. Unnamed constraints report their expression.
+		regexp.MustCompile(`CHECK constraint failed: (?P<name>\w+)$`),
+	},
+}
+
+// parseColumns parses the columns list of an error message. e.g. "`a`, `b`" or "t.a, t.b".
+func parseColumns(s string) []string {
+	columns := strings.Split(s, ",")
+	for i, c := range columns {
+		c = strings.Trim(strings.TrimSpace(c), "`\"")
+		if j := strings.LastIndexByte(c, '.'); j != -1 {
+			c = c[j+1:]
+		}
+		columns[i] = c
+	}
+	return columns
+}
+
 // IsUniqueConstraintError reports if the error resulted from a DB uniqueness constraint violation.
 // e.g. duplicate value in unique index.
 func IsUniqueConstraintError(err error) bool {
@@ -72,3 +181,34 @@ func IsCheckConstraintError(err error) bool {
 	}
 	return false
 }
+
+// IsRetryableError reports if the error resulted from a transient database failure,
+// and the transaction that caused it can be retried. e.g. serialization failure or deadlock.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	// Postgres drivers expose the SQLSTATE code of the error.
+	var e interface{ SQLState() string }
+	if errors.As(err, &e) {
+		switch e.SQLState() {
+		case "40001", "40P01":
+			return true
+		}
+	}
+	for _, s := range []string{
+		"Error 1213",                 // MySQL (Deadlock found when trying to get lock).
+		"Error 1205",                 // MySQL (Lock wait timeout exceeded).
+		"SQLSTATE 40001",             // Postgres (serialization failure).
+		"SQLSTATE 40P01",             // Postgres (deadlock detected).
+		"could not serialize access", // Postgres (serialization failure).
+		"deadlock detected",          // Postgres (deadlock detected).
+		"database is locked",         // SQLite (SQLITE_BUSY).
+		"SQLITE_BUSY",                // SQLite (SQLITE_BUSY).
+	} {
+		if strings.Contains(err.Error(), s) {
+			return true
+		}
+	}
+	return false
+}
@@ -13,10 +13,15 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"reflect"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlarray"
 	"entgo.io/ent/schema/field"
 )
 
@@ -51,11 +56,23 @@ func (r Rel) String() (s string) {
 
 // A ConstraintError represents an error from mutation that violates a specific constraint.
 type ConstraintError struct {
-	msg string
+	// Kind of the violated constraint.
+	Kind ConstraintKind
+	// Name of the violated constraint or index, if it is known.
+	Name string
+	// Table of the violated constraint, if it is known.
+	Table string
+	// Columns of the violated constraint, if they are known.
+	Columns []string
+	msg     string
+	wrap    error
 }
 
 func (e ConstraintError) Error() string { return e.msg }
 
+// Unwrap returns the underlying database error, if there is one.
+func (e *ConstraintError) Unwrap() error { return e.wrap }
+
 // A Step provides a path-step information to the traversal functions.
 type Step struct {
 	// From is the source of the step.
@@ -83,6 +100,11 @@ type Step struct {
 		Columns []string
 		// Inverse indicates if the edge is an inverse edge.
 		Inverse bool
+		// TypeColumn and TypeValue are set for polymorphic edges that can point to
+		// more than one table. In this case, the foreign-key column holds the ID of
+		// the neighbor, and the TypeColumn (in the same table) holds its type.
+		TypeColumn string
+		TypeValue  any
 	}
 	// To is the dest of the path (the neighbors).
 	To struct {
@@ -128,6 +150,22 @@ func Edge(rel Rel, inverse bool, table string, columns ...string) StepOption {
 	}
 }
 
+// Polymorphic sets the type column of a polymorphic edge and the value
+// it holds for the neighbors table.
+//
+//	NewStep(
+//		From("comments", "id", V),
+//		To("posts", "id"),
+//		Edge(M2O, false, "comments", "target_id"),
+//		Polymorphic("target_type", "Post"),
+//	)
+func Polymorphic(column string, value any) StepOption {
+	return func(s *Step) {
+		s.Edge.TypeColumn = column
+		s.Edge.TypeValue = value
+	}
+}
+
 // NewStep gets list of options and returns a configured step.
 //
 //	NewStep(
@@ -155,6 +193,11 @@ func (s *Step) ToEdgeOwner() bool {
 	return s.Edge.Rel == O2M || (s.Edge.Rel == O2O && !s.Edge.Inverse)
 }
 
+// Polymorphic reports if the step is of a polymorphic edge.
+func (s *Step) Polymorphic() bool {
+	return s.Edge.TypeColumn != ""
+}
+
 // ThroughEdgeTable returns true if the step is through a join-table.
 func (s *Step) ThroughEdgeTable() bool {
 	return s.Edge.Rel == M2M
@@ -184,6 +227,9 @@ func Neighbors(dialect string, s *Step) (q *sql.Selector) {
 		t2 := builder.Select(s.Edge.Columns[0]).
 			From(builder.Table(s.Edge.Table).Schema(s.Edge.Schema)).
 			Where(sql.EQ(s.From.Column, s.From.V))
+		if s.Polymorphic() {
+			t2.Where(sql.EQ(s.Edge.TypeColumn, s.Edge.TypeValue))
+		}
 		q = builder.Select().
 			From(t1).
 			Join(t2).
@@ -192,6 +238,9 @@ func Neighbors(dialect string, s *Step) (q *sql.Selector) {
 		q = builder.Select().
 			From(builder.Table(s.To.Table).Schema(s.To.Schema)).
 			Where(sql.EQ(s.Edge.Columns[0], s.From.V))
+		if s.Polymorphic() {
+			q.Where(sql.EQ(s.Edge.TypeColumn, s.Edge.TypeValue))
+		}
 	}
 	return q
 }
@@ -221,6 +270,9 @@ func SetNeighbors(dialect string, s *Step) (q *sql.Selector) {
 	case s.FromEdgeOwner():
 		t1 := builder.Table(s.To.Table).Schema(s.To.Schema)
 		set.Select(set.C(s.Edge.Columns[0]))
+		if s.Polymorphic() {
+			set.Where(sql.EQ(set.C(s.Edge.TypeColumn), s.Edge.TypeValue))
+		}
 		q = builder.Select().
 			From(t1).
 			Join(set).
@@ -232,10 +284,409 @@ func SetNeighbors(dialect string, s *Step) (q *sql.Selector) {
 			From(t1).
 			Join(set).
 			On(t1.C(s.Edge.Columns[0]), set.C(s.From.Column))
+		if s.Polymorphic() {
+			q.Where(sql.EQ(t1.C(s.Edge.TypeColumn), s.Edge.TypeValue))
+		}
 	}
 	return q
 }
 
+// RecursiveSpec holds the information for traversing a self-referencing
+// edge recursively using the WITH RECURSIVE clause.
+type RecursiveSpec struct {
+	// MaxDepth limits the number of edges that are traversed from
+	// the starting vertices. Zero means there is no limit.
+	MaxDepth int
+
+	// Depth, if not empty, is the name of the column the depth of each
+	// vertex is selected to by the SelectDepth modifier. The depth of a
+	// vertex is the length of the shortest path from the starting vertices.
+	Depth string
+
+	// Predicate, if not nil, is applied to the vertices that are visited by the
+	// traversal (e.g. excluding soft-deleted vertices). Unlike the predicates of
+	// the returned selector, vertices that do not match it are not traversed.
+	Predicate func(*sql.Selector)
+}
+
+// Names of the common-table-expressions and the derived table that are
+// used by the recursive traversal.
+const (
+	recursiveTraverse  = "traverse"
+	recursiveReachable = "reachable"
+	recursiveDepths    = "depths"
+	recursiveDepth     = "depth"
+)
+
+// RecursiveNeighbors returns a Selector for evaluating the path-step recursively
+// and getting all vertices that are reachable from the step source. For example,
+// all descendants or ancestors of a set of vertices. The step must connect a table
+// to itself, and its source can be either a vertex identifier or a Selector.
+//
+// Cycles in the graph are not traversed more than once. Without depth tracking,
+// the recursion relies on the UNION semantics, and with depth tracking, the recursion
+// is limited by the MaxDepth option or by the number of reachable vertices.
+func RecursiveNeighbors(dialect string, s *Step, spec *RecursiveSpec) *sql.Selector {
+	var (
+		builder = sql.Dialect(dialect)
+		to      = builder.Table(s.To.Table).Schema(s.To.Schema)
+		depth   = spec.MaxDepth > 0 || spec.Depth != ""
+		with    = sql.WithRecursive(recursiveTraverse, s.To.Column)
+	)
+	if depth {
+		with = sql.WithRecursive(recursiveTraverse, s.To.Column, recursiveDepth)
+	}
+	// In case the depth is not limited, the reachable vertices are collected
+	// first, in order to limit the depth by their number and avoid cycles.
+	if spec.MaxDepth <= 0 && depth {
+		seed, step, _ := recursiveStep(dialect, recursiveSource(s), recursiveReachable, spec.Predicate)
+		with = sql.WithRecursive(recursiveReachable, s.To.Column).
+			As(seed.Union(step)).
+			With(recursiveTraverse, s.To.Column, recursiveDepth)
+	}
+	seed, step, r := recursiveStep(dialect, s, recursiveTraverse, spec.Predicate)
+	if depth {
+		seed.AppendSelectExpr(sql.Expr("1"))
+		step.AppendSelectExpr(sql.Expr(r.C(recursiveDepth) + " + 1"))
+		switch {
+		case spec.MaxDepth > 0:
+			step.Where(sql.LT(r.C(recursiveDepth), spec.MaxDepth))
+		default:
+			count := builder.Select(sql.Count("*")).From(builder.Table(recursiveReachable))
+			step.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(r.C(recursiveDepth)).WriteOp(sql.OpLT).Wrap(func(b *sql.Builder) {
+					b.Join(count)
+				})
+			}))
+		}
+	}
+	with.As(seed.Union(step))
+	t1 := builder.Table(recursiveTraverse)
+	if spec.Depth == "" {
+		return builder.Select().
+			From(to).
+			Where(sql.In(to.C(s.To.Column), builder.Select(t1.C(s.To.Column)).From(t1))).
+			Prefix(with)
+	}
+	depths := builder.Select(t1.C(s.To.Column)).
+		AppendSelectAs(sql.Min(t1.C(recursiveDepth)), recursiveDepth).
+		From(t1).
+		GroupBy(t1.C(s.To.Column)).
+		As(recursiveDepths)
+	return builder.Select().
+		From(to).
+		Join(depths).
+		On(to.C(s.To.Column), depths.C(s.To.Column)).
+		Prefix(with)
+}
+
+// SelectDepth returns a modifier that appends the depth column computed
+// by RecursiveNeighbors to the selected columns, if it was requested by
+// the spec.
+func SelectDepth(spec *RecursiveSpec) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if spec == nil || spec.Depth == "" {
+			return
+		}
+		t := sql.Dialect(s.Dialect()).Table(recursiveDepths)
+		s.AppendSelectAs(t.C(recursiveDepth), spec.Depth)
+	}
+}
+
+// recursiveSource returns a copy of the step with a copy of its source
+// Selector, as the neighbors functions modify the source selection.
+func recursiveSource(s *Step) *Step {
+	c := *s
+	if set, ok := s.From.V.(*sql.Selector); ok {
+		c.From.V = set.Clone()
+	}
+	return &c
+}
+
+// recursiveStep returns the non-recursive and the recursive terms of the
+// traversal. The first selects the direct neighbors of the step source,
+// and the second selects the neighbors of the already visited vertices
+// that are held by the named table. If the predicate is not nil, both
+// terms select only the neighbors that match it.
+func recursiveStep(dialect string, s *Step, name string, pred func(*sql.Selector)) (seed, step *sql.Selector, r *sql.SelectTable) {
+	if _, ok := s.From.V.(*sql.Selector); ok {
+		seed = SetNeighbors(dialect, s)
+	} else {
+		seed = Neighbors(dialect, s)
+	}
+	builder := sql.Dialect(dialect)
+	seed.Select(seed.C(s.To.Column))
+	r = builder.Table(name)
+	switch {
+	case s.ThroughEdgeTable():
+		pk1, pk2 := s.Edge.Columns[1], s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		join := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		step = builder.Select(join.C(pk1)).
+			From(join).
+			Join(r).
+			On(join.C(pk2), r.C(s.To.Column))
+	case s.FromEdgeOwner():
+		t1 := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		step = builder.Select(t1.C(s.Edge.Columns[0])).
+			From(t1).
+			Join(r).
+			On(t1.C(s.From.Column), r.C(s.To.Column)).
+			Where(sql.NotNull(t1.C(s.Edge.Columns[0])))
+	case s.ToEdgeOwner():
+		t1 := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		step = builder.Select(t1.C(s.To.Column)).
+			From(t1).
+			Join(r).
+			On(t1.C(s.Edge.Columns[0]), r.C(s.To.Column))
+	}
+	if pred != nil {
+		// Both terms select a single column that holds the neighbor identifiers.
+		for _, t := range []*sql.Selector{seed, step} {
+			to := builder.Table(s.To.Table).Schema(s.To.Schema)
+			matched := builder.Select(to.C(s.To.Column)).From(to)
+			pred(matched)
+			t.Where(sql.In(t.SelectedColumns()[0], matched))
+		}
+	}
+	return seed, step, r
+}
+
+// Names of the derived table and the columns that are
+// used by the shortest-path and the reachability queries.
+const (
+	pathAdjacency = "adjacency"
+	pathSrc       = "src"
+	pathDst       = "dst"
+)
+
+// ShortestPath returns the identifiers of the vertices along the shortest path
+// between the from and the to vertices, including both of them, by traversing
+// the given path-steps. The steps must connect a table to itself (e.g. friends
+// or parent/children), and their sources are ignored.
+//
+// The path is computed by a breadth-first search that executes one query per level,
+// and stops at the level where the to vertex is found, or when no unvisited vertices
+// are left. The returned identifiers have the same type as the from identifier, and
+// a NotFoundError is returned in case there is no path between the two vertices.
+func ShortestPath(ctx context.Context, drv dialect.Driver, from, to driver.Value, steps ...*Step) ([]driver.Value, error) {
+	return ShortestPathWith(ctx, drv, from, to, nil, steps...)
+}
+
+// ShortestPathWith is like ShortestPath, but traverses only the vertices that match the given
+// predicate (e.g. excluding soft-deleted vertices, or vertices of other tenants). Vertices that
+// do not match it cannot be the source or the target of a path.
+func ShortestPathWith(ctx context.Context, drv dialect.Driver, from, to driver.Value, pred func(*sql.Selector), steps ...*Step) ([]driver.Value, error) {
+	if len(steps) == 0 {
+		return nil, errors.New("sqlgraph: missing steps for shortest path")
+	}
+	p := &pathSearch{from: from, to: to, pred: pred, steps: steps}
+	prev, found, err := p.search(ctx, drv)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, &NotFoundError{table: steps[0].To.Table, id: to}
+	}
+	// Walk the path backwards, from the target vertex to the source.
+	path := []driver.Value{to}
+	for k := p.tk; k != p.fk; {
+		v, ok := prev[k]
+		if !ok {
+			return nil, fmt.Errorf("sqlgraph: missing vertex %v in shortest path", k)
+		}
+		if k, err = valueKey(v); err != nil {
+			return nil, err
+		}
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	path[0] = from
+	return path, nil
+}
+
+// Reachable reports if the to vertex is reachable from the from vertex
+// by traversing the given path-steps. See ShortestPath for more info.
+func Reachable(ctx context.Context, drv dialect.Driver, from, to driver.Value, steps ...*Step) (bool, error) {
+	return ReachableWith(ctx, drv, from, to, nil, steps...)
+}
+
+// ReachableWith is like Reachable, but traverses only the vertices that match the given predicate.
+func ReachableWith(ctx context.Context, drv dialect.Driver, from, to driver.Value, pred func(*sql.Selector), steps ...*Step) (bool, error) {
+	if len(steps) == 0 {
+		return false, errors.New("sqlgraph: missing steps for reachability check")
+	}
+	p := &pathSearch{from: from, to: to, pred: pred, steps: steps}
+	_, found, err := p.search(ctx, drv)
+	return found, err
+}
+
+// pathSearch holds the state of a breadth-first search between two vertices.
+type pathSearch struct {
+	from, to driver.Value
+	fk, tk   any
+	pred     func(*sql.Selector)
+	steps    []*Step
+}
+
+// search runs the breadth-first search from the source vertex, and returns the vertex
+// that each visited vertex was reached from, keyed by its valueKey. It reports if the
+// target vertex was found.
+func (p *pathSearch) search(ctx context.Context, drv dialect.Driver) (map[any]driver.Value, bool, error) {
+	if p.from == nil || p.to == nil {
+		return nil, false, errors.New("sqlgraph: missing vertices for path query")
+	}
+	var err error
+	if p.fk, err = valueKey(p.from); err != nil {
+		return nil, false, err
+	}
+	if p.tk, err = valueKey(p.to); err != nil {
+		return nil, false, err
+	}
+	if p.fk == p.tk {
+		found, err := p.vertexExists(ctx, drv)
+		return nil, found, err
+	}
+	var (
+		typ      = reflect.TypeOf(p.from)
+		prev     = map[any]driver.Value{p.fk: nil}
+		frontier = []driver.Value{p.from}
+		// Leave room for the arguments of the predicate.
+		size = maxPlaceholders(drv.Dialect()) / 2
+	)
+	for len(frontier) > 0 {
+		var next []driver.Value
+		for i := 0; i < len(frontier); i += size {
+			edges, err := p.edges(ctx, drv, typ, frontier[i:min(i+size, len(frontier))])
+			if err != nil {
+				return nil, false, err
+			}
+			for _, e := range edges {
+				k, err := valueKey(e[1])
+				if err != nil {
+					return nil, false, err
+				}
+				if _, ok := prev[k]; ok {
+					continue
+				}
+				prev[k] = e[0]
+				if k == p.tk {
+					return prev, true, nil
+				}
+				next = append(next, e[1])
+			}
+		}
+		frontier = next
+	}
+	return prev, false, nil
+}
+
+// edges returns the (src, dst) pairs of the edges that go out of the given vertices.
+func (p *pathSearch) edges(ctx context.Context, drv dialect.Driver, typ reflect.Type, src []driver.Value) ([][2]driver.Value, error) {
+	selector, adj := p.adjacencyTable(sql.Dialect(drv.Dialect()))
+	query, args := selector.
+		Select(adj.C(pathSrc), adj.C(pathDst)).
+		Where(sql.InValues(adj.C(pathSrc), src...)).
+		Query()
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var edges [][2]driver.Value
+	for rows.Next() {
+		s, d := reflect.New(typ), reflect.New(typ)
+		if err := rows.Scan(s.Interface(), d.Interface()); err != nil {
+			return nil, err
+		}
+		edges = append(edges, [2]driver.Value{s.Elem().Interface(), d.Elem().Interface()})
+	}
+	return edges, rows.Err()
+}
+
+// vertexExists reports if the source vertex exists and matches the predicate of the search.
+// It is used for paths from a vertex to itself, which do not traverse any edge.
+func (p *pathSearch) vertexExists(ctx context.Context, drv dialect.Driver) (bool, error) {
+	if p.pred == nil {
+		return true, nil
+	}
+	builder := sql.Dialect(drv.Dialect())
+	s := p.steps[0]
+	t1 := builder.Table(s.To.Table).Schema(s.To.Schema)
+	selector := builder.Select().
+		AppendSelectExpr(sql.Expr("1")).
+		From(t1).
+		Where(sql.EQ(t1.C(s.To.Column), p.from)).
+		Limit(1)
+	p.pred(selector)
+	query, args := selector.Query()
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	found := rows.Next()
+	return found, rows.Err()
+}
+
+// adjacencyTable returns a Selector that selects from the adjacency list
+// of the search steps. That is, a derived table of (src, dst) pairs that
+// holds the edges of all steps in their traversal direction. If the search
+// has a predicate, edges between vertices that do not match it are skipped.
+func (p *pathSearch) adjacencyTable(builder *sql.DialectBuilder) (*sql.Selector, *sql.Selector) {
+	var adj *sql.Selector
+	for _, s := range p.steps {
+		var edges *sql.Selector
+		t1 := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		switch {
+		case s.ThroughEdgeTable():
+			src, dst := s.Edge.Columns[0], s.Edge.Columns[1]
+			if s.Edge.Inverse {
+				src, dst = dst, src
+			}
+			edges = builder.Select().
+				AppendSelectAs(t1.C(src), pathSrc).
+				AppendSelectAs(t1.C(dst), pathDst).
+				From(t1)
+		case s.FromEdgeOwner():
+			edges = builder.Select().
+				AppendSelectAs(t1.C(s.From.Column), pathSrc).
+				AppendSelectAs(t1.C(s.Edge.Columns[0]), pathDst).
+				From(t1).
+				Where(sql.NotNull(t1.C(s.Edge.Columns[0])))
+		case s.ToEdgeOwner():
+			edges = builder.Select().
+				AppendSelectAs(t1.C(s.Edge.Columns[0]), pathSrc).
+				AppendSelectAs(t1.C(s.To.Column), pathDst).
+				From(t1).
+				Where(sql.NotNull(t1.C(s.Edge.Columns[0])))
+		}
+		if adj == nil {
+			adj = edges
+		} else {
+			adj.UnionAll(edges)
+		}
+	}
+	adj.As(pathAdjacency)
+	selector := builder.Select().From(adj)
+	if pred := p.pred; pred != nil {
+		matched := func() *sql.Selector {
+			s := p.steps[0]
+			t1 := builder.Table(s.To.Table).Schema(s.To.Schema)
+			matched := builder.Select(t1.C(s.To.Column)).From(t1)
+			pred(matched)
+			return matched
+		}
+		selector.Where(sql.And(
+			sql.In(adj.C(pathSrc), matched()),
+			sql.In(adj.C(pathDst), matched()),
+		))
+	}
+	return selector, adj
+}
+
 // HasNeighbors applies on the given Selector a neighbors check.
 func HasNeighbors(q *sql.Selector, s *Step) {
 	builder := sql.Dialect(q.Dialect())
@@ -254,6 +705,9 @@ func HasNeighbors(q *sql.Selector, s *Step) {
 		)
 	case s.FromEdgeOwner():
 		q.Where(sql.NotNull(q.C(s.Edge.Columns[0])))
+		if s.Polymorphic() {
+			q.Where(sql.EQ(q.C(s.Edge.TypeColumn), s.Edge.TypeValue))
+		}
 	case s.ToEdgeOwner():
 		to := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
 		// In case the edge reside on the same table, give
@@ -261,18 +715,18 @@ func HasNeighbors(q *sql.Selector, s *Step) {
 		if s.From.Table == s.Edge.Table {
 			to.As(fmt.Sprintf("%s_edge", s.Edge.Table))
 		}
-		q.Where(
-			sql.Exists(
-				builder.Select(to.C(s.Edge.Columns[0])).
-					From(to).
-					Where(
-						sql.ColumnsEQ(
-							q.C(s.From.Column),
-							to.C(s.Edge.Columns[0]),
-						),
-					),
-			),
-		)
+		matches := builder.Select(to.C(s.Edge.Columns[0])).
+			From(to).
+			Where(
+				sql.ColumnsEQ(
+					q.C(s.From.Column),
+					to.C(s.Edge.Columns[0]),
+				),
+			)
+		if s.Polymorphic() {
+			matches.Where(sql.EQ(to.C(s.Edge.TypeColumn), s.Edge.TypeValue))
+		}
+		q.Where(sql.Exists(matches))
 	}
 }
 
@@ -322,6 +776,9 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 			),
 		)
 		pred(matches)
+		if s.Polymorphic() {
+			q.Where(sql.EQ(q.C(s.Edge.TypeColumn), s.Edge.TypeValue))
+		}
 		q.Where(sql.Exists(matches))
 	case s.ToEdgeOwner():
 		to := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
@@ -347,6 +804,9 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 				to.C(s.Edge.Columns[0]),
 			),
 		)
+		if s.Polymorphic() {
+			matches.Where(sql.EQ(to.C(s.Edge.TypeColumn), s.Edge.TypeValue))
+		}
 		pred(matches)
 		q.Where(sql.Exists(matches))
 	}
@@ -662,7 +1122,10 @@ type (
 		Schema      string
 		Columns     []string
 		ID          *FieldSpec   // primary key.
-		CompositeID []*FieldSpec // composite id (edge schema).
+		CompositeID []*FieldSpec // composite id (edge schemas, or types with multi-column primary keys).
+		// Computed holds the SQL expressions of the computed columns. Columns
+		// that exist in this map are selected by their expressions.
+		Computed map[string]func(*sql.Selector) sql.Querier
 	}
 )
 
@@ -682,6 +1145,20 @@ func (n *NodeSpec) AddColumnOnce(column string) *NodeSpec {
 	return n
 }
 
+// SelectColumns sets the columns selection of the given selector. Computed columns
+// are selected by their expressions, and are aliased to their column names.
+func SelectColumns(s *sql.Selector, columns []string, computed map[string]func(*sql.Selector) sql.Querier) *sql.Selector {
+	s.Select()
+	for _, c := range columns {
+		if x, ok := computed[c]; ok {
+			s.AppendSelectExprAs(x(s), c)
+		} else {
+			s.AppendSelect(s.C(c))
+		}
+	}
+	return s
+}
+
 // FieldValues returns the values of additional fields that were set on the join-table.
 func (e *EdgeTarget) FieldValues() []any {
 	vs := make([]any, len(e.Fields))
@@ -711,6 +1188,19 @@ type (
 		//	}
 		//
 		OnConflict []sql.ConflictOption
+
+		// Generated holds the columns that are computed by the database on
+		// insert. They are returned by the INSERT statement in PostgreSQL and
+		// SQLite, and read back in the insert transaction in MySQL.
+		Generated *GeneratedSpec
+	}
+
+	// GeneratedSpec holds the information for reading back
+	// the generated columns of a node after its creation.
+	GeneratedSpec struct {
+		Columns    []string
+		ScanValues func(columns []string) ([]any, error)
+		Assign     func(columns []string, values []any) error
 	}
 
 	// BatchCreateSpec holds the information for creating
@@ -718,6 +1208,12 @@ type (
 	BatchCreateSpec struct {
 		Nodes []*CreateSpec
 
+		// BatchSize limits the number of rows inserted by each statement.
+		// Regardless of its value, batches are split into chunks by the
+		// placeholder limit of the database (and the packet size in MySQL),
+		// and all chunks are inserted in one transaction.
+		BatchSize int
+
 		// The OnConflict option allows providing on-conflict
 		// options to the INSERT statement.
 		//
@@ -782,10 +1278,20 @@ type (
 		Fields    FieldMut
 		Predicate func(*sql.Selector)
 		Modifiers []func(*sql.UpdateBuilder)
+		// Version holds the version column that is used for optimistic
+		// locking. The version is incremented by every update, and if its
+		// value is set, the node is updated only if its version matches.
+		Version *FieldSpec
 
 		ScanValues func(columns []string) ([]any, error)
 		Assign     func(columns []string, values []any) error
 	}
+
+	// BatchUpdateSpec holds the information for updating
+	// a batch of nodes, each with its own changes, in the graph.
+	BatchUpdateSpec struct {
+		Nodes []*UpdateSpec
+	}
 )
 
 // NewUpdateSpec creates a new node update spec.
@@ -838,6 +1344,16 @@ func (u *UpdateSpec) ClearField(column string, t field.Type) {
 	})
 }
 
+// SetVersion sets the version column of the update spec. A nil value
+// increments the version without checking its current value.
+func (u *UpdateSpec) SetVersion(column string, t field.Type, value driver.Value) {
+	u.Version = &FieldSpec{
+		Column: column,
+		Type:   t,
+		Value:  value,
+	}
+}
+
 // UpdateNode applies the UpdateSpec on one node in the graph.
 func UpdateNode(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) error {
 	tx, err := drv.Tx(ctx)
@@ -853,12 +1369,31 @@ func UpdateNode(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) error
 }
 
 // UpdateNodes applies the UpdateSpec on a set of nodes in the graph.
+// If the Assign function of the spec is set, the updated rows are
+// scanned and passed to it.
 func UpdateNodes(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) (int, error) {
 	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect())}
 	cr := &updater{UpdateSpec: spec, graph: gr}
 	return cr.nodes(ctx, drv)
 }
 
+// BatchUpdate applies the UpdateSpec of each node in the batch. Nodes that change the
+// same set of columns are updated using a single statement (split into chunks by the
+// placeholder limit of the dialect), and all statements are executed in one transaction.
+// Nodes that update edges or use statement modifiers are updated one by one.
+func BatchUpdate(ctx context.Context, drv dialect.Driver, spec *BatchUpdateSpec) error {
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	gr := graph{tx: tx, builder: sql.Dialect(drv.Dialect())}
+	bu := &batchUpdater{BatchUpdateSpec: spec, graph: gr, dialect: drv.Dialect()}
+	if err := bu.nodes(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
 // NotFoundError returns when trying to update an
 // entity, and it was not found in the database.
 type NotFoundError struct {
@@ -870,11 +1405,35 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("record with id %v not found in table %s", e.id, e.table)
 }
 
+// StaleObjectError returns when trying to update an entity
+// with a version that does not match its version in the database.
+type StaleObjectError struct {
+	table   string
+	id      driver.Value
+	version driver.Value
+}
+
+func (e *StaleObjectError) Error() string {
+	return fmt.Sprintf("record with id %v in table %s was modified (expected version %v)", e.id, e.table, e.version)
+}
+
+// IsStaleObject returns a boolean indicating whether the error
+// is a stale object error that was returned by UpdateNode.
+func IsStaleObject(err error) bool {
+	var e *StaleObjectError
+	return errors.As(err, &e)
+}
+
 // DeleteSpec holds the information for delete one
 // or more nodes in the graph.
 type DeleteSpec struct {
 	Node      *NodeSpec
 	Predicate func(*sql.Selector)
+
+	// ScanValues and Assign are used for scanning the deleted
+	// rows, in case they should be returned by DeleteNodes.
+	ScanValues func(columns []string) ([]any, error)
+	Assign     func(columns []string, values []any) error
 }
 
 // NewDeleteSpec creates a new node deletion spec.
@@ -882,7 +1441,9 @@ func NewDeleteSpec(table string, id *FieldSpec) *DeleteSpec {
 	return &DeleteSpec{Node: &NodeSpec{Table: table, ID: id}}
 }
 
-// DeleteNodes applies the DeleteSpec on the graph.
+// DeleteNodes applies the DeleteSpec on the graph. If the Assign
+// function of the spec is set, the deleted rows are scanned and
+// passed to it.
 func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int, error) {
 	var (
 		res     sql.Result
@@ -894,6 +1455,9 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	if pred := spec.Predicate; pred != nil {
 		pred(selector)
 	}
+	if spec.Assign != nil {
+		return deleteReturning(ctx, drv, spec, selector)
+	}
 	query, args := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector).Query()
 	if err := drv.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -905,6 +1469,52 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	return int(affected), nil
 }
 
+// deleteReturning deletes the nodes matched by the selector, and scans them using the
+// Assign function of the spec. MySQL does not support the RETURNING clause. Therefore,
+// the rows are selected and locked before they are deleted, in the same transaction.
+func deleteReturning(ctx context.Context, drv dialect.Driver, spec *DeleteSpec, selector *sql.Selector) (int, error) {
+	builder := sql.Dialect(drv.Dialect())
+	stmt := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector)
+	if drv.Dialect() != dialect.MySQL {
+		rows := &sql.Rows{}
+		query, args := stmt.Returning(spec.Node.Columns...).Query()
+		if err := drv.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		return scanRows(rows, spec.ScanValues, spec.Assign)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := func() (int, error) {
+		rows := &sql.Rows{}
+		query, args := selector.Select(spec.Node.Columns...).ForUpdate().Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		n, err := scanRows(rows, spec.ScanValues, spec.Assign)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if err := rows.Close(); err != nil || n == 0 {
+			return 0, err
+		}
+		var res sql.Result
+		query, args = stmt.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return 0, err
+		}
+		return n, nil
+	}()
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return affected, tx.Commit()
+}
+
 // QuerySpec holds the information for querying
 // nodes in the graph.
 type QuerySpec struct {
@@ -1042,7 +1652,14 @@ func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
 	}
 	// If no columns were selected in count,
 	// the default selection is by node ids.
-	columns := q.Node.Columns
+	columns := make([]string, 0, len(q.Node.Columns))
+	for _, c := range q.Node.Columns {
+		// Computed columns are not counted, as they are
+		// expressions and not columns of the node table.
+		if _, ok := q.Node.Computed[c]; !ok {
+			columns = append(columns, c)
+		}
+	}
 	if len(columns) == 0 && q.Node.ID != nil {
 		columns = append(columns, q.Node.ID.Column)
 	}
@@ -1072,7 +1689,7 @@ func (q *query) selector(ctx context.Context) (*sql.Selector, error) {
 		selector = q.From
 		selector.WithContext(ctx)
 	}
-	selector.Select(selector.Columns(q.Node.Columns...)...)
+	SelectColumns(selector, q.Node.Columns, q.Node.Computed)
 	if order := q.Order; order != nil {
 		order(selector)
 	}
@@ -1117,17 +1734,21 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	case u.Node.ID != nil:
 		id = u.Node.ID.Value
 		idp = sql.EQ(u.Node.ID.Column, id)
-	case len(u.Node.CompositeID) == 2:
-		idp = sql.And(
-			sql.EQ(u.Node.CompositeID[0].Column, u.Node.CompositeID[0].Value),
-			sql.EQ(u.Node.CompositeID[1].Column, u.Node.CompositeID[1].Value),
-		)
-	case len(u.Node.CompositeID) != 2:
+	case len(u.Node.CompositeID) > 1:
+		preds := make([]*sql.Predicate, len(u.Node.CompositeID))
+		for i, f := range u.Node.CompositeID {
+			preds[i] = sql.EQ(f.Column, f.Value)
+		}
+		idp = sql.And(preds...)
+	case len(u.Node.CompositeID) == 1:
 		return fmt.Errorf("sql/sqlgraph: invalid composite id for update table %q", u.Node.Table)
 	default:
 		return fmt.Errorf("sql/sqlgraph: missing node id for update table %q", u.Node.Table)
 	}
 	update := u.builder.Update(u.Node.Table).Schema(u.Node.Schema).Where(idp)
+	if v := u.Version; v != nil && v.Value != nil {
+		update.Where(sql.EQ(v.Column, v.Value))
+	}
 	if pred := u.Predicate; pred != nil {
 		selector := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema))
 		pred(selector)
@@ -1153,11 +1774,15 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 			return err
 		}
 		// In case there are zero affected rows by this statement, we need to distinguish
-		// between the case of "record was not found" and "record was not changed".
-		if affected == 0 && u.Predicate != nil {
-			if err := u.ensureExists(ctx); err != nil {
+		// between the case of "record was not found" and "record was not changed". If the
+		// node is versioned, an existing record that was not changed has a different version.
+		if affected == 0 && (u.Predicate != nil || u.Version != nil) {
+			if err := u.ensureExists(ctx, idp); err != nil {
 				return err
 			}
+			if u.Version != nil {
+				return &StaleObjectError{table: u.Node.Table, id: u.nodeID(), version: u.Version.Value}
+			}
 		}
 	}
 	if id != nil {
@@ -1190,8 +1815,12 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 		addEdges   = EdgeSpecs(u.Edges.Add).GroupRel()
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 		multiple   = hasExternalEdges(addEdges, clearEdges)
-		update     = u.builder.Update(u.Node.Table).Schema(u.Node.Schema)
-		selector   = u.builder.Select().
+		returning  = u.Assign != nil
+		// MySQL does not support the RETURNING clause. Hence, the updated
+		// rows are locked before the update, and queried after it.
+		emulate  = returning && drv.Dialect() == dialect.MySQL
+		update   = u.builder.Update(u.Node.Table).Schema(u.Node.Schema)
+		selector = u.builder.Select().
 				From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
 				WithContext(ctx)
 	)
@@ -1200,14 +1829,17 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	// the returned nodes are used for updating external tables.
 	case u.Node.ID != nil:
 		selector.Select(u.Node.ID.Column)
-	case len(u.Node.CompositeID) == 2:
+	case len(u.Node.CompositeID) > 1:
 		// Other edge-schemas (M2M tables) cannot be updated by this operation.
 		// Also, in case there is a need to update an external foreign-key, it must
 		// be a single value and the user should use the "update by id" API instead.
 		if multiple {
-			return 0, fmt.Errorf("sql/sqlgraph: update edge schema table %q cannot update external tables", u.Node.Table)
+			return 0, fmt.Errorf("sql/sqlgraph: update table %q with composite id cannot update external tables", u.Node.Table)
 		}
-	case len(u.Node.CompositeID) != 2:
+		if emulate {
+			return 0, fmt.Errorf("sql/sqlgraph: update table %q with composite id cannot return the updated rows in MySQL", u.Node.Table)
+		}
+	case len(u.Node.CompositeID) == 1:
 		return 0, fmt.Errorf("sql/sqlgraph: invalid composite id for update table %q", u.Node.Table)
 	default:
 		return 0, fmt.Errorf("sql/sqlgraph: missing node id for update table %q", u.Node.Table)
@@ -1219,8 +1851,11 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 		pred(selector)
 	}
 	// In case of single statement update, avoid opening a transaction manually.
-	if !multiple {
+	if !multiple && !emulate {
 		update.FromSelect(selector)
+		if returning {
+			return u.updateReturning(ctx, update)
+		}
 		return u.updateTable(ctx, update)
 	}
 	tx, err := drv.Tx(ctx)
@@ -1230,10 +1865,13 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	u.tx = tx
 	affected, err := func() (int, error) {
 		var (
-			ids         []driver.Value
-			rows        = &sql.Rows{}
-			query, args = selector.Query()
+			ids  []driver.Value
+			rows = &sql.Rows{}
 		)
+		if emulate {
+			selector.ForUpdate()
+		}
+		query, args := selector.Query()
 		if err := u.tx.Query(ctx, query, args, rows); err != nil {
 			return 0, fmt.Errorf("querying table %s: %w", u.Node.Table, err)
 		}
@@ -1251,12 +1889,34 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 		// In case of multi statement update, that change can
 		// affect more than 1 table, and therefore, we return
 		// the list of ids as number of affected records.
-		if _, err := u.updateTable(ctx, update); err != nil {
+		var n int
+		if returning && !emulate {
+			n, err = u.updateReturning(ctx, update)
+		} else {
+			_, err = u.updateTable(ctx, update)
+		}
+		if err != nil {
 			return 0, err
 		}
 		if err := u.setExternalEdges(ctx, ids, addEdges, clearEdges); err != nil {
 			return 0, err
 		}
+		// Rows are queried after the update in MySQL, or in case only
+		// external edges were updated, and no rows were returned.
+		if emulate || (returning && n == 0) {
+			rows := &sql.Rows{}
+			query, args := u.builder.Select(u.Node.Columns...).
+				From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
+				Where(matchID(u.Node.ID.Column, ids)).
+				Query()
+			if err := u.tx.Query(ctx, query, args, rows); err != nil {
+				return 0, err
+			}
+			defer rows.Close()
+			if _, err := scanRows(rows, u.ScanValues, u.Assign); err != nil {
+				return 0, err
+			}
+		}
 		return len(ids), nil
 	}()
 	if err != nil {
@@ -1265,6 +1925,29 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	return affected, tx.Commit()
 }
 
+// updateReturning executes the update statement, and scans
+// the updated rows using the Assign function of the spec.
+func (u *updater) updateReturning(ctx context.Context, stmt *sql.UpdateBuilder) (int, error) {
+	for _, m := range u.Modifiers {
+		m(stmt)
+	}
+	if err := stmt.Err(); err != nil {
+		return 0, err
+	}
+	if stmt.Empty() {
+		return 0, nil
+	}
+	var (
+		rows        = &sql.Rows{}
+		query, args = stmt.Returning(u.Node.Columns...).Query()
+	)
+	if err := u.tx.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	return scanRows(rows, u.ScanValues, u.Assign)
+}
+
 func (u *updater) updateTable(ctx context.Context, stmt *sql.UpdateBuilder) (int, error) {
 	for _, m := range u.Modifiers {
 		m(stmt)
@@ -1331,7 +2014,7 @@ func (u *updater) setTableColumns(update *sql.UpdateBuilder, addEdges, clearEdge
 			update.SetNull(col)
 		}
 	}
-	err := setTableColumns(u.Fields.Set, addEdges, func(column string, value driver.Value) {
+	err := setTableColumns(update.Dialect(), u.Fields.Set, addEdges, func(column string, value driver.Value) {
 		update.Set(column, value)
 	})
 	if err != nil {
@@ -1340,6 +2023,9 @@ func (u *updater) setTableColumns(update *sql.UpdateBuilder, addEdges, clearEdge
 	for _, fi := range u.Fields.Add {
 		update.Add(fi.Column, fi.Value)
 	}
+	if u.Version != nil {
+		update.Add(u.Version.Column, 1)
+	}
 	return nil
 }
 
@@ -1353,10 +2039,7 @@ func (u *updater) scan(rows *sql.Rows) error {
 		if err := rows.Err(); err != nil {
 			return err
 		}
-		if len(u.Node.CompositeID) == 2 {
-			return &NotFoundError{table: u.Node.Table, id: []driver.Value{u.Node.CompositeID[0].Value, u.Node.CompositeID[1].Value}}
-		}
-		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+		return &NotFoundError{table: u.Node.Table, id: u.nodeID()}
 	}
 	values, err := u.ScanValues(columns)
 	if err != nil {
@@ -1376,9 +2059,23 @@ func (u *updater) scan(rows *sql.Rows) error {
 	return nil
 }
 
-func (u *updater) ensureExists(ctx context.Context) error {
-	exists := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
-	u.Predicate(exists)
+// nodeID returns the identifier of the updated node.
+func (u *updater) nodeID() driver.Value {
+	if len(u.Node.CompositeID) > 1 {
+		id := make([]driver.Value, len(u.Node.CompositeID))
+		for i, f := range u.Node.CompositeID {
+			id[i] = f.Value
+		}
+		return id
+	}
+	return u.Node.ID.Value
+}
+
+func (u *updater) ensureExists(ctx context.Context, idp *sql.Predicate) error {
+	exists := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).Where(idp)
+	if u.Predicate != nil {
+		u.Predicate(exists)
+	}
 	query, args := u.builder.SelectExpr(sql.Exists(exists)).Query()
 	rows := &sql.Rows{}
 	if err := u.tx.Query(ctx, query, args, rows); err != nil {
@@ -1390,25 +2087,677 @@ func (u *updater) ensureExists(ctx context.Context) error {
 		return err
 	}
 	if !found {
-		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+		return &NotFoundError{table: u.Node.Table, id: u.nodeID()}
 	}
 	return nil
 }
 
-type creator struct {
-	graph
-	*CreateSpec
+type (
+	batchUpdater struct {
+		graph
+		*BatchUpdateSpec
+		dialect string
+	}
+	// batchRow holds a node that is updated by a batch statement.
+	batchRow struct {
+		*UpdateSpec
+		pred  *sql.Predicate // optional predicate of the node.
+		nargs int            // number of placeholders used by the node.
+	}
+)
+
+// batchAlias is the alias of the derived table that holds the
+// values of the nodes in PostgreSQL and MySQL batch statements.
+const batchAlias = "batch"
+
+// maxPlaceholders returns the maximum number of placeholders
+// (bound parameters) that can be used by one statement.
+func maxPlaceholders(d string) int {
+	switch d {
+	case dialect.SQLite:
+		// SQLITE_MAX_VARIABLE_NUMBER defaults to 32766 since v3.32.0.
+		return 32766
+	default:
+		// MySQL and PostgreSQL use 16-bit integers for counting placeholders.
+		return math.MaxUint16
+	}
 }
 
-func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
+func (u *batchUpdater) nodes(ctx context.Context) error {
 	var (
-		edges  = EdgeSpecs(c.Edges).GroupRel()
-		insert = c.builder.Insert(c.Table).Schema(c.Schema).Default()
+		groups [][]*batchRow
+		shapes = make(map[string]int)
 	)
-	if err := c.setTableColumns(insert, edges); err != nil {
-		return err
-	}
-	tx, err := c.mayTx(ctx, drv, edges)
+	for _, n := range u.Nodes {
+		if n.Node.ID == nil {
+			return fmt.Errorf("sql/sqlgraph: missing node id for batch update of table %q", n.Node.Table)
+		}
+		// Edges and statement modifiers cannot be expressed
+		// by a batch statement, and are applied node by node.
+		if len(n.Edges.Add) > 0 || len(n.Edges.Clear) > 0 || len(n.Modifiers) > 0 {
+			up := &updater{UpdateSpec: n, graph: u.graph}
+			if err := up.node(ctx, u.tx); err != nil {
+				return err
+			}
+			continue
+		}
+		// Values are encoded before they are written to the batch statement.
+		for _, f := range n.Fields.Set {
+			v, err := fieldValue(u.dialect, f)
+			if err != nil {
+				return err
+			}
+			f.Value = v
+		}
+		r := &batchRow{UpdateSpec: n, nargs: u.rowArgs(n)}
+		if n.Predicate != nil {
+			selector := u.builder.Select().From(u.builder.Table(n.Node.Table).Schema(n.Node.Schema))
+			n.Predicate(selector)
+			if r.pred = selector.P(); r.pred != nil {
+				_, args := r.pred.Query()
+				// The predicate is joined with the node id.
+				r.nargs += len(args) + 1
+			}
+		}
+		key := batchShape(n)
+		i, ok := shapes[key]
+		if !ok {
+			i = len(groups)
+			shapes[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], r)
+	}
+	limit := maxPlaceholders(u.dialect)
+	for _, rows := range groups {
+		for len(rows) > 0 {
+			n, total := 1, rows[0].nargs
+			for n < len(rows) && total+rows[n].nargs <= limit {
+				total += rows[n].nargs
+				n++
+			}
+			if err := u.batch(ctx, rows[:n]); err != nil {
+				return err
+			}
+			rows = rows[n:]
+		}
+	}
+	return nil
+}
+
+// rowArgs returns the number of placeholders used
+// by the node in a batch statement, excluding its predicate.
+func (u *batchUpdater) rowArgs(n *UpdateSpec) int {
+	values := len(batchValues(n))
+	if u.dialect == dialect.SQLite {
+		// Values are written as "WHEN id THEN value" cases.
+		return 2*values + 1
+	}
+	return values + 1
+}
+
+// batch updates a chunk of nodes that share the same shape.
+func (u *batchUpdater) batch(ctx context.Context, rows []*batchRow) error {
+	spec := rows[0].UpdateSpec
+	if v := spec.Version; v != nil && v.Value != nil {
+		if err := u.checkVersions(ctx, rows); err != nil {
+			return err
+		}
+	}
+	affected, err := u.updateBatch(ctx, rows)
+	if err != nil {
+		return err
+	}
+	if err := u.scanBatch(ctx, rows); err != nil {
+		return err
+	}
+	if affected >= len(rows) {
+		return nil
+	}
+	ids := make([]driver.Value, len(rows))
+	for i, r := range rows {
+		ids[i] = r.Node.ID.Value
+	}
+	// Versions were checked above. Hence, the unmatched rows were filtered
+	// out by their predicates. MySQL counts only rows that were actually
+	// changed by the update, and unless the version column is incremented,
+	// unmatched rows cannot be detected this way.
+	if u.dialect != dialect.MySQL || spec.Version != nil {
+		return &NotFoundError{table: spec.Node.Table, id: ids}
+	}
+	return nil
+}
+
+// checkVersions locks the rows of the given nodes and returns a StaleObjectError for each node
+// whose version does not match its version in the database, and a NotFoundError for the nodes
+// that do not exist. It runs before the batch statement, as the latter cannot report these rows.
+func (u *batchUpdater) checkVersions(ctx context.Context, rows []*batchRow) error {
+	var (
+		spec     = rows[0].UpdateSpec
+		ids      = make([]driver.Value, len(rows))
+		versions = make(map[string]string, len(rows))
+	)
+	for i, r := range rows {
+		ids[i] = r.Node.ID.Value
+	}
+	selector := u.builder.Select(spec.Node.ID.Column, spec.Version.Column).
+		From(u.builder.Table(spec.Node.Table).Schema(spec.Node.Schema)).
+		Where(sql.InValues(spec.Node.ID.Column, ids...)).
+		WithContext(ctx)
+	// SQLite does not support row-level locks, and
+	// serializes the writes of the transaction instead.
+	if u.dialect != dialect.SQLite {
+		selector.ForUpdate()
+	}
+	query, args := selector.Query()
+	rs := &sql.Rows{}
+	if err := u.tx.Query(ctx, query, args, rs); err != nil {
+		return err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var id, version any
+		if err := rs.Scan(&id, &version); err != nil {
+			return fmt.Errorf("failed scanning rows: %w", err)
+		}
+		k, err := versionKey(id)
+		if err != nil {
+			return err
+		}
+		if versions[k], err = versionKey(version); err != nil {
+			return err
+		}
+	}
+	if err := rs.Err(); err != nil {
+		return err
+	}
+	var (
+		stale   []error
+		missing []driver.Value
+	)
+	for _, r := range rows {
+		k, err := versionKey(r.Node.ID.Value)
+		if err != nil {
+			return err
+		}
+		expected, err := versionKey(r.Version.Value)
+		if err != nil {
+			return err
+		}
+		switch v, ok := versions[k]; {
+		case !ok:
+			missing = append(missing, r.Node.ID.Value)
+		case v != expected:
+			stale = append(stale, &StaleObjectError{table: spec.Node.Table, id: r.Node.ID.Value, version: r.Version.Value})
+		}
+	}
+	var notFound error
+	switch len(missing) {
+	case 0:
+	case 1:
+		notFound = &NotFoundError{table: spec.Node.Table, id: missing[0]}
+	default:
+		notFound = &NotFoundError{table: spec.Node.Table, id: missing}
+	}
+	switch {
+	case len(stale) == 1 && notFound == nil:
+		return stale[0]
+	case len(stale) > 0:
+		return errors.Join(append(stale, notFound)...)
+	default:
+		return notFound
+	}
+}
+
+// versionKey returns the textual representation of a value that was scanned
+// without its column type, as drivers may return integers as raw bytes.
+func versionKey(v any) (string, error) {
+	k, err := valueKey(v)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(k), nil
+}
+
+// updateBatch executes the update statement of the
+// given nodes, and returns the number of affected rows.
+func (u *batchUpdater) updateBatch(ctx context.Context, rows []*batchRow) (int, error) {
+	spec := rows[0].UpdateSpec
+	if len(spec.Fields.Set)+len(spec.Fields.Add)+len(spec.Fields.Clear) == 0 && spec.Version == nil {
+		return len(rows), nil
+	}
+	b := &sql.Builder{}
+	b.SetDialect(u.dialect)
+	switch u.dialect {
+	case dialect.Postgres:
+		u.updateFrom(b, rows)
+	case dialect.MySQL:
+		u.updateJoin(b, rows)
+	default:
+		u.updateCase(b, rows)
+	}
+	if err := b.Err(); err != nil {
+		return 0, err
+	}
+	var (
+		res         sql.Result
+		query, args = b.Query()
+	)
+	if err := u.tx.Exec(ctx, query, args, &res); err != nil {
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// updateFrom writes a PostgreSQL statement in the form of:
+//
+//	UPDATE "t" SET "c" = "batch"."c0"
+//	FROM (SELECT "id", "c" FROM "t" WHERE FALSE UNION ALL VALUES ($1, $2), ($3, $4)) AS "batch"("id", "c0")
+//	WHERE "t"."id" = "batch"."id"
+//
+// The empty SELECT binds the types of the placeholders to the types of the columns.
+func (u *batchUpdater) updateFrom(b *sql.Builder, rows []*batchRow) {
+	spec := rows[0].UpdateSpec
+	columns := batchColumns(spec)
+	b.WriteString("UPDATE ")
+	writeBatchTable(b, spec.Node)
+	b.WriteString(" SET ")
+	setBatch(b, spec, false, func(i int) {
+		b.Ident(batchAlias).WriteString(".").Ident(batchColumn(i))
+	})
+	b.WriteString(" FROM (SELECT ").IdentComma(append([]string{spec.Node.ID.Column}, columns...)...)
+	b.WriteString(" FROM ")
+	writeBatchTable(b, spec.Node)
+	b.WriteString(" WHERE FALSE UNION ALL VALUES ")
+	for i, r := range rows {
+		if i > 0 {
+			b.Comma()
+		}
+		b.Wrap(func(b *sql.Builder) {
+			b.Arg(r.Node.ID.Value)
+			for _, v := range batchValues(r.UpdateSpec) {
+				b.Comma().Arg(v)
+			}
+		})
+	}
+	b.WriteString(") AS ").Ident(batchAlias).Wrap(func(b *sql.Builder) {
+		b.Ident(spec.Node.ID.Column)
+		for i := range columns {
+			b.Comma().Ident(batchColumn(i))
+		}
+	})
+	b.WriteString(" WHERE ")
+	u.whereBatch(b, rows)
+}
+
+// updateJoin writes a MySQL statement in the form of:
+//
+//	UPDATE `t` JOIN (SELECT ? AS `id`, ? AS `c0` UNION ALL SELECT ?, ?) AS `batch`
+//	ON `t`.`id` = `batch`.`id` SET `t`.`c` = `batch`.`c0`
+func (u *batchUpdater) updateJoin(b *sql.Builder, rows []*batchRow) {
+	spec := rows[0].UpdateSpec
+	b.WriteString("UPDATE ")
+	writeBatchTable(b, spec.Node)
+	b.WriteString(" JOIN (")
+	for i, r := range rows {
+		if i > 0 {
+			b.WriteString(" UNION ALL ")
+		}
+		b.WriteString("SELECT ").Arg(r.Node.ID.Value)
+		if i == 0 {
+			b.WriteString(" AS ").Ident(spec.Node.ID.Column)
+		}
+		for j, v := range batchValues(r.UpdateSpec) {
+			b.Comma().Arg(v)
+			if i == 0 {
+				b.WriteString(" AS ").Ident(batchColumn(j))
+			}
+		}
+	}
+	b.WriteString(") AS ").Ident(batchAlias).WriteString(" ON ")
+	u.whereBatch(b, rows)
+	b.WriteString(" SET ")
+	setBatch(b, spec, true, func(i int) {
+		b.Ident(batchAlias).WriteString(".").Ident(batchColumn(i))
+	})
+}
+
+// updateCase writes a SQLite statement in the form of:
+//
+//	UPDATE `t` SET `c` = CASE `t`.`id` WHEN ? THEN ? WHEN ? THEN ? END WHERE `t`.`id` IN (?, ?)
+func (u *batchUpdater) updateCase(b *sql.Builder, rows []*batchRow) {
+	spec := rows[0].UpdateSpec
+	b.WriteString("UPDATE ")
+	writeBatchTable(b, spec.Node)
+	b.WriteString(" SET ")
+	setBatch(b, spec, false, func(i int) {
+		u.caseBatch(b, rows, i)
+	})
+	b.WriteString(" WHERE ")
+	u.whereBatch(b, rows)
+}
+
+// caseBatch writes a CASE expression that selects the i-th value of each node.
+func (u *batchUpdater) caseBatch(b *sql.Builder, rows []*batchRow, i int) {
+	spec := rows[0].UpdateSpec
+	b.WriteString("CASE ")
+	writeBatchColumn(b, spec.Node.Table, spec.Node.ID.Column)
+	for _, r := range rows {
+		b.WriteString(" WHEN ").Arg(r.Node.ID.Value).WriteString(" THEN ").Arg(batchValues(r.UpdateSpec)[i])
+	}
+	b.WriteString(" END")
+}
+
+// whereBatch writes the condition that matches the table rows to the batch
+// nodes, including their expected versions and their optional predicates.
+func (u *batchUpdater) whereBatch(b *sql.Builder, rows []*batchRow) {
+	var (
+		spec    = rows[0].UpdateSpec
+		table   = spec.Node.Table
+		version = -1
+	)
+	if v := spec.Version; v != nil && v.Value != nil {
+		version = len(batchValues(spec)) - 1
+	}
+	switch u.dialect {
+	case dialect.Postgres, dialect.MySQL:
+		writeBatchColumn(b, table, spec.Node.ID.Column)
+		b.WriteString(" = ").Ident(batchAlias).WriteString(".").Ident(spec.Node.ID.Column)
+		if version != -1 {
+			b.WriteString(" AND ")
+			writeBatchColumn(b, table, spec.Version.Column)
+			b.WriteString(" = ").Ident(batchAlias).WriteString(".").Ident(batchColumn(version))
+		}
+	default:
+		writeBatchColumn(b, table, spec.Node.ID.Column)
+		b.WriteString(" IN ").Wrap(func(b *sql.Builder) {
+			for i, r := range rows {
+				if i > 0 {
+					b.Comma()
+				}
+				b.Arg(r.Node.ID.Value)
+			}
+		})
+		if version != -1 {
+			b.WriteString(" AND ")
+			writeBatchColumn(b, table, spec.Version.Column)
+			b.WriteString(" = ")
+			u.caseBatch(b, rows, version)
+		}
+	}
+	var (
+		ids   []driver.Value
+		preds []*sql.Predicate
+		c     = u.builder.Table(table).C(spec.Node.ID.Column)
+	)
+	for _, r := range rows {
+		if r.pred == nil {
+			ids = append(ids, r.Node.ID.Value)
+		} else {
+			preds = append(preds, sql.And(sql.EQ(c, r.Node.ID.Value), r.pred))
+		}
+	}
+	if len(preds) == 0 {
+		return
+	}
+	if len(ids) > 0 {
+		preds = append(preds, sql.InValues(c, ids...))
+	}
+	b.WriteString(" AND ").Wrap(func(b *sql.Builder) {
+		b.Join(sql.Or(preds...))
+	})
+}
+
+// scanBatch queries the updated nodes and assigns them to their specs.
+func (u *batchUpdater) scanBatch(ctx context.Context, rows []*batchRow) error {
+	var (
+		keys   []string
+		groups = make(map[string][]*batchRow)
+	)
+	// Nodes may select different columns.
+	for _, r := range rows {
+		if r.ScanValues == nil || r.Assign == nil {
+			continue
+		}
+		k := strings.Join(r.Node.Columns, ",")
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], r)
+	}
+	for _, k := range keys {
+		if err := u.scanNodes(ctx, groups[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *batchUpdater) scanNodes(ctx context.Context, rows []*batchRow) error {
+	var (
+		spec  = rows[0].UpdateSpec
+		ids   = make([]driver.Value, len(rows))
+		nodes = make(map[any]*batchRow, len(rows))
+	)
+	for i, r := range rows {
+		k, err := valueKey(r.Node.ID.Value)
+		if err != nil {
+			return err
+		}
+		ids[i] = r.Node.ID.Value
+		nodes[k] = r
+	}
+	selector := u.builder.Select(spec.Node.Columns...).
+		From(u.builder.Table(spec.Node.Table).Schema(spec.Node.Schema)).
+		Where(sql.InValues(spec.Node.ID.Column, ids...)).
+		WithContext(ctx)
+	query, args := selector.Query()
+	rs := &sql.Rows{}
+	if err := u.tx.Query(ctx, query, args, rs); err != nil {
+		return err
+	}
+	defer rs.Close()
+	columns, err := rs.Columns()
+	if err != nil {
+		return err
+	}
+	idx := -1
+	for i, c := range columns {
+		if c == spec.Node.ID.Column {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return fmt.Errorf("sql/sqlgraph: missing id column %q in batch update of table %q", spec.Node.ID.Column, spec.Node.Table)
+	}
+	for rs.Next() {
+		values, err := spec.ScanValues(columns)
+		if err != nil {
+			return err
+		}
+		for i, v := range values {
+			if _, ok := v.(*sql.UnknownType); ok {
+				values[i] = sql.ScanTypeOf(rs, i)
+			}
+		}
+		if err := rs.Scan(values...); err != nil {
+			return fmt.Errorf("failed scanning rows: %w", err)
+		}
+		k, err := valueKey(values[idx])
+		if err != nil {
+			return err
+		}
+		r, ok := nodes[k]
+		if !ok {
+			return fmt.Errorf("sql/sqlgraph: unexpected id %v in batch update of table %q", k, spec.Node.Table)
+		}
+		if err := r.Assign(columns, values); err != nil {
+			return err
+		}
+		delete(nodes, k)
+	}
+	if err := rs.Err(); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if k, _ := valueKey(r.Node.ID.Value); nodes[k] != nil {
+			return &NotFoundError{table: r.Node.Table, id: r.Node.ID.Value}
+		}
+	}
+	return nil
+}
+
+// setBatch writes the SET clause of a batch statement. The value function writes
+// the expression of the i-th value, as returned by batchValues.
+func setBatch(b *sql.Builder, spec *UpdateSpec, qualify bool, value func(int)) {
+	var (
+		i      int
+		table  = spec.Node.Table
+		column = func(c string) {
+			if i > 0 {
+				b.Comma()
+			}
+			i++
+			if qualify {
+				writeBatchColumn(b, table, c)
+			} else {
+				b.Ident(c)
+			}
+			b.WriteString(" = ")
+		}
+	)
+	for _, f := range spec.Fields.Clear {
+		column(f.Column)
+		b.WriteString("NULL")
+	}
+	for j, f := range spec.Fields.Set {
+		column(f.Column)
+		value(j)
+	}
+	for j, f := range spec.Fields.Add {
+		column(f.Column)
+		b.WriteString("COALESCE(")
+		writeBatchColumn(b, table, f.Column)
+		b.WriteString(", 0) + ")
+		value(len(spec.Fields.Set) + j)
+	}
+	if v := spec.Version; v != nil {
+		column(v.Column)
+		b.WriteString("COALESCE(")
+		writeBatchColumn(b, table, v.Column)
+		b.WriteString(", 0) + 1")
+	}
+}
+
+// batchShape returns a key that identifies the columns
+// changed by the node, and the way they are changed.
+func batchShape(n *UpdateSpec) string {
+	key := []string{n.Node.Schema, n.Node.Table}
+	for _, f := range n.Fields.Set {
+		key = append(key, "set:"+f.Column)
+	}
+	for _, f := range n.Fields.Add {
+		key = append(key, "add:"+f.Column)
+	}
+	for _, f := range n.Fields.Clear {
+		key = append(key, "clear:"+f.Column)
+	}
+	if v := n.Version; v != nil {
+		key = append(key, "version:"+v.Column)
+		if v.Value != nil {
+			key = append(key, "check")
+		}
+	}
+	return strings.Join(key, "|")
+}
+
+// batchValues returns the values of the node that are passed to
+// the batch statement: set values, added values and the expected version.
+func batchValues(n *UpdateSpec) []driver.Value {
+	values := make([]driver.Value, 0, len(n.Fields.Set)+len(n.Fields.Add)+1)
+	for _, f := range n.Fields.Set {
+		values = append(values, f.Value)
+	}
+	for _, f := range n.Fields.Add {
+		values = append(values, f.Value)
+	}
+	if v := n.Version; v != nil && v.Value != nil {
+		values = append(values, v.Value)
+	}
+	return values
+}
+
+// batchColumns returns the columns of the values returned by batchValues.
+func batchColumns(n *UpdateSpec) []string {
+	columns := make([]string, 0, len(n.Fields.Set)+len(n.Fields.Add)+1)
+	for _, f := range n.Fields.Set {
+		columns = append(columns, f.Column)
+	}
+	for _, f := range n.Fields.Add {
+		columns = append(columns, f.Column)
+	}
+	if v := n.Version; v != nil && v.Value != nil {
+		columns = append(columns, v.Column)
+	}
+	return columns
+}
+
+// batchColumn returns the name of the i-th value column in the batch table.
+func batchColumn(i int) string {
+	return "c" + strconv.Itoa(i)
+}
+
+func writeBatchTable(b *sql.Builder, n *NodeSpec) {
+	if n.Schema != "" && b.Dialect() != dialect.SQLite {
+		b.Ident(n.Schema).WriteString(".")
+	}
+	b.Ident(n.Table)
+}
+
+func writeBatchColumn(b *sql.Builder, table, column string) {
+	b.Ident(table).WriteString(".").Ident(column)
+}
+
+// valueKey returns a comparable key of the given identifier,
+// used for matching the scanned rows to their update specs.
+func valueKey(v any) (any, error) {
+	if p, ok := v.(*any); ok {
+		v = *p
+	}
+	if vr, ok := v.(driver.Valuer); ok {
+		var err error
+		if v, err = vr.Value(); err != nil {
+			return nil, err
+		}
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := v.([]byte); ok {
+		return string(b), nil
+	}
+	return v, nil
+}
+
+type creator struct {
+	graph
+	*CreateSpec
+}
+
+func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
+	var (
+		edges  = EdgeSpecs(c.Edges).GroupRel()
+		insert = c.builder.Insert(c.Table).Schema(c.Schema).Default()
+	)
+	if err := c.setTableColumns(insert, edges); err != nil {
+		return err
+	}
+	tx, err := c.mayTx(ctx, drv, edges)
 	if err != nil {
 		return err
 	}
@@ -1426,6 +2775,9 @@ func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
 		if err := c.insert(ctx, insert); err != nil {
 			return err
 		}
+		if err := c.graph.selectGenerated(ctx, drv.Dialect(), []*CreateSpec{c.CreateSpec}); err != nil {
+			return err
+		}
 		if err := c.graph.addM2MEdges(ctx, []driver.Value{c.ID.Value}, edges[M2M]); err != nil {
 			return err
 		}
@@ -1438,7 +2790,7 @@ func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
 
 // mayTx opens a new transaction if the create operation spans across multiple statements.
 func (c *creator) mayTx(ctx context.Context, drv dialect.Driver, edges map[Rel][]*EdgeSpec) (dialect.Tx, error) {
-	if !hasExternalEdges(edges, nil) {
+	if !hasExternalEdges(edges, nil) && !selectsGenerated(drv.Dialect(), c.CreateSpec) {
 		return dialect.NopTx(drv), nil
 	}
 	tx, err := drv.Tx(ctx)
@@ -1451,7 +2803,7 @@ func (c *creator) mayTx(ctx context.Context, drv dialect.Driver, edges map[Rel][
 
 // setTableColumns sets the table columns and foreign_keys used in insert.
 func (c *creator) setTableColumns(insert *sql.InsertBuilder, edges map[Rel][]*EdgeSpec) error {
-	err := setTableColumns(c.Fields, edges, func(column string, value driver.Value) {
+	err := setTableColumns(insert.Dialect(), c.Fields, edges, func(column string, value driver.Value) {
 		insert.Set(column, value)
 	})
 	return err
@@ -1465,7 +2817,8 @@ func (c *creator) insert(ctx context.Context, insert *sql.InsertBuilder) error {
 		insert.Set(c.ID.Column, c.ID.Value)
 		// In case of "ON CONFLICT", the record may exist in the
 		// database, and we need to get back the database id field.
-		if len(c.CreateSpec.OnConflict) == 0 {
+		// Generated columns are also returned by the statement.
+		if len(c.CreateSpec.OnConflict) == 0 && (!hasGenerated(c.CreateSpec) || insert.Dialect() == dialect.MySQL) {
 			query, args, err := insert.QueryErr()
 			if err != nil {
 				return err
@@ -1473,7 +2826,7 @@ func (c *creator) insert(ctx context.Context, insert *sql.InsertBuilder) error {
 			return c.tx.Exec(ctx, query, args, nil)
 		}
 	}
-	return c.insertLastID(ctx, insert.Returning(c.ID.Column))
+	return c.insertLastID(ctx, insert.Returning(returningColumns(c.CreateSpec)...))
 }
 
 // ensureConflict ensures the ON CONFLICT is added to the insert statement.
@@ -1521,7 +2874,7 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 			values[i][node.ID.Column] = node.ID.Value
 		}
 		edges := EdgeSpecs(node.Edges).GroupRel()
-		err := setTableColumns(node.Fields, edges, func(column string, value driver.Value) {
+		err := setTableColumns(drv.Dialect(), node.Fields, edges, func(column string, value driver.Value) {
 			columns[column] = struct{}{}
 			values[i][column] = value
 		})
@@ -1543,30 +2896,47 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 			}
 		}
 	}
-	sorted := keys(columns)
-	insert := c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Default().Columns(sorted...)
-	for i := range values {
-		vs := make([]any, len(sorted))
-		for j, c := range sorted {
-			vs[j] = values[i][c]
-		}
-		insert.Values(vs...)
-	}
-	tx, err := c.mayTx(ctx, drv)
+	var (
+		sorted = keys(columns)
+		chunks = c.chunks(drv.Dialect(), sorted, values)
+	)
+	tx, err := c.mayTx(ctx, drv, len(chunks) > 1)
 	if err != nil {
 		return err
 	}
 	c.tx = tx
 	if err := func() error {
-		// In case the spec does not contain an ID field, we assume
-		// we interact with an edge-schema with composite primary key.
+		// Rows are inserted in chunks, in order to avoid exceeding
+		// the placeholder or the packet size limits of the database.
+		for _, chunk := range chunks {
+			i, j := chunk[0], chunk[1]
+			insert := c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Default().Columns(sorted...)
+			for _, v := range values[i:j] {
+				vs := make([]any, len(sorted))
+				for k, c := range sorted {
+					vs[k] = v[c]
+				}
+				insert.Values(vs...)
+			}
+			// In case the spec does not contain an ID field, we assume
+			// we interact with an edge-schema with composite primary key.
+			if c.Nodes[0].ID == nil {
+				c.ensureConflict(insert)
+				query, args := insert.Query()
+				if err := tx.Exec(ctx, query, args, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := c.batchInsert(ctx, tx, insert, c.Nodes[i:j]); err != nil {
+				return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+			}
+		}
 		if c.Nodes[0].ID == nil {
-			c.ensureConflict(insert)
-			query, args := insert.Query()
-			return tx.Exec(ctx, query, args, nil)
+			return nil
 		}
-		if err := c.batchInsert(ctx, tx, insert); err != nil {
-			return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+		if err := c.graph.selectGenerated(ctx, drv.Dialect(), c.Nodes); err != nil {
+			return err
 		}
 		if err := c.batchAddM2M(ctx, c.BatchCreateSpec); err != nil {
 			return err
@@ -1586,8 +2956,213 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 	return tx.Commit()
 }
 
+// hasGenerated reports if the node has columns that are computed by the database.
+func hasGenerated(spec *CreateSpec) bool {
+	return spec.Generated != nil && len(spec.Generated.Columns) > 0
+}
+
+// selectsGenerated reports if the generated columns of the node are read
+// back by a separate query, because the dialect does not support RETURNING.
+func selectsGenerated(d string, spec *CreateSpec) bool {
+	return d == dialect.MySQL && spec.ID != nil && hasGenerated(spec)
+}
+
+// returningColumns returns the columns that are returned by the INSERT statement of the node.
+func returningColumns(spec *CreateSpec) []string {
+	columns := []string{spec.ID.Column}
+	if hasGenerated(spec) {
+		columns = append(columns, spec.Generated.Columns...)
+	}
+	return columns
+}
+
+// scanReturning scans the ID and the generated columns of
+// the node from the current row of the RETURNING clause.
+func scanReturning(rows *sql.Rows, node *CreateSpec) error {
+	var (
+		id     int64
+		values []any
+	)
+	switch _, ok := node.ID.Value.(field.ValueScanner); {
+	case ok:
+		// If the ID implements the sql.Scanner
+		// interface it should be a pointer type.
+		values = append(values, node.ID.Value)
+	case node.ID.Type.Numeric():
+		// Normalize the type to int64 to make it looks
+		// like LastInsertId.
+		values = append(values, &id)
+	default:
+		values = append(values, &node.ID.Value)
+	}
+	if hasGenerated(node) {
+		columns := node.Generated.Columns
+		generated, err := node.Generated.ScanValues(columns)
+		if err != nil {
+			return err
+		}
+		for i, v := range generated {
+			if _, ok := v.(*sql.UnknownType); ok {
+				generated[i] = sql.ScanTypeOf(rows, i+1)
+			}
+		}
+		if err := rows.Scan(append(values, generated...)...); err != nil {
+			return err
+		}
+		if err := node.Generated.Assign(columns, generated); err != nil {
+			return err
+		}
+	} else if err := rows.Scan(values...); err != nil {
+		return err
+	}
+	if _, ok := values[0].(*int64); ok {
+		node.ID.Value = id
+	}
+	return nil
+}
+
+// selectGenerated reads back the generated columns of the given nodes after they were inserted
+// in MySQL, which does not support the RETURNING clause. The columns are read by one query in the
+// insert transaction, and therefore, from the primary database in case of a replica setup.
+func (g *graph) selectGenerated(ctx context.Context, d string, nodes []*CreateSpec) error {
+	if len(nodes) == 0 || !selectsGenerated(d, nodes[0]) {
+		return nil
+	}
+	var (
+		spec = nodes[0]
+		byID = make(map[any]*CreateSpec, len(nodes))
+		ids  = make([]driver.Value, 0, len(nodes))
+		typ  reflect.Type
+		size = maxPlaceholders(d)
+	)
+	for _, node := range nodes {
+		if node.ID.Value == nil {
+			continue
+		}
+		k, err := valueKey(node.ID.Value)
+		if err != nil {
+			return err
+		}
+		byID[k] = node
+		ids = append(ids, node.ID.Value)
+		typ = reflect.TypeOf(node.ID.Value)
+	}
+	for i := 0; i < len(ids); i += size {
+		rows := &sql.Rows{}
+		query, args := g.builder.Select(append([]string{spec.ID.Column}, spec.Generated.Columns...)...).
+			From(g.builder.Table(spec.Table).Schema(spec.Schema)).
+			Where(sql.InValues(spec.ID.Column, ids[i:min(i+size, len(ids))]...)).
+			Query()
+		if err := g.tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+		if err := func() error {
+			defer rows.Close()
+			for rows.Next() {
+				id := reflect.New(typ)
+				values, err := spec.Generated.ScanValues(spec.Generated.Columns)
+				if err != nil {
+					return err
+				}
+				for i, v := range values {
+					if _, ok := v.(*sql.UnknownType); ok {
+						values[i] = sql.ScanTypeOf(rows, i+1)
+					}
+				}
+				if err := rows.Scan(append([]any{id.Interface()}, values...)...); err != nil {
+					return fmt.Errorf("failed scanning rows: %w", err)
+				}
+				k, err := valueKey(id.Elem().Interface())
+				if err != nil {
+					return err
+				}
+				node, ok := byID[k]
+				if !ok {
+					return fmt.Errorf("sqlgraph: unexpected id %v of generated columns", k)
+				}
+				// Each node assigns the values to its own entity.
+				if err := node.Generated.Assign(spec.Generated.Columns, values); err != nil {
+					return err
+				}
+				delete(byID, k)
+			}
+			return rows.Err()
+		}(); err != nil {
+			return err
+		}
+	}
+	for _, node := range byID {
+		return &NotFoundError{table: node.Table, id: node.ID.Value}
+	}
+	return nil
+}
+
+// batchSize returns the maximum number of rows that can be inserted by one statement.
+func (c *batchCreator) batchSize(dialect string, columns int) int {
+	size := maxPlaceholders(dialect) / max(columns, 1)
+	if c.BatchSize > 0 && c.BatchSize < size {
+		size = c.BatchSize
+	}
+	return max(size, 1)
+}
+
+// mysqlMaxPacket bounds the estimated size of the batch insert statements in MySQL, as
+// statements that exceed the max_allowed_packet of the server are rejected. It matches
+// the default of MySQL 5.7 (4MB). Servers with a smaller limit require the BatchSize option.
+const mysqlMaxPacket = 4 << 20
+
+// chunks splits the given rows into the [i, j) ranges that are inserted by one statement. Chunks
+// are bounded by the batch size, and in MySQL, also by the estimated size of their statements.
+func (c *batchCreator) chunks(d string, columns []string, values []map[string]driver.Value) [][2]int {
+	var (
+		chunks [][2]int
+		size   = c.batchSize(d, len(columns))
+	)
+	for i := 0; i < len(values); {
+		j, bytes := i, 0
+		for j < len(values) && j-i < size {
+			if d == dialect.MySQL {
+				n := 0
+				for _, column := range columns {
+					n += valueSize(values[j][column])
+				}
+				// A chunk holds at least one row.
+				if bytes += n; j > i && bytes > mysqlMaxPacket {
+					break
+				}
+			}
+			j++
+		}
+		chunks = append(chunks, [2]int{i, j})
+		i = j
+	}
+	return chunks
+}
+
+// valueSize returns the estimated size of the given value in a statement.
+func valueSize(v driver.Value) int {
+	// Placeholders and separators.
+	const overhead = 4
+	if vr, ok := v.(driver.Valuer); ok {
+		if dv, err := vr.Value(); err == nil {
+			v = dv
+		}
+	}
+	switch v := v.(type) {
+	case string:
+		return overhead + len(v)
+	case []byte:
+		return overhead + len(v)
+	default:
+		return overhead + 8
+	}
+}
+
 // mayTx opens a new transaction if the create operation spans across multiple statements.
-func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.Tx, error) {
+func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver, chunked bool) (dialect.Tx, error) {
+	if chunked || selectsGenerated(drv.Dialect(), c.Nodes[0]) {
+		return drv.Tx(ctx)
+	}
 	for _, node := range c.Nodes {
 		for _, edge := range node.Edges {
 			if isExternalEdge(edge) {
@@ -1599,9 +3174,9 @@ func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.T
 }
 
 // batchInsert inserts a batch of nodes to their table and sets their ID if it was not provided by the user.
-func (c *batchCreator) batchInsert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+func (c *batchCreator) batchInsert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder, nodes []*CreateSpec) error {
 	c.ensureConflict(insert)
-	return c.insertLastIDs(ctx, tx, insert.Returning(c.Nodes[0].ID.Column))
+	return c.insertLastIDs(ctx, tx, insert.Returning(returningColumns(nodes[0])...), nodes)
 }
 
 // ensureConflict ensures the ON CONFLICT is added to the insert statement.
@@ -1846,7 +3421,12 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 		// Setting the FK value of the "other" table without clearing it before, is not allowed.
 		// Including no-op (same id), because we rely on "affected" to determine if the FK set.
 		if ids := edge.Target.Nodes; int(affected) < len(ids) {
-			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0])}
+			return &ConstraintError{
+				Kind:    UniqueConstraint,
+				Table:   edge.Table,
+				Columns: edge.Columns,
+				msg:     fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0]),
+			}
 		}
 	}
 	return nil
@@ -1876,17 +3456,11 @@ func isExternalEdge(e *EdgeSpec) bool {
 }
 
 // setTableColumns is shared between updater and creator.
-func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) (err error) {
+func setTableColumns(d string, fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) error {
 	for _, fi := range fields {
-		value := fi.Value
-		if fi.Type == field.TypeJSON {
-			buf, err := json.Marshal(value)
-			if err != nil {
-				return fmt.Errorf("marshal value for column %s: %w", fi.Column, err)
-			}
-			// If the underlying driver does not support JSON types,
-			// driver.DefaultParameterConverter will convert it to uint8.
-			value = json.RawMessage(buf)
+		value, err := fieldValue(d, fi)
+		if err != nil {
+			return err
 		}
 		set(fi.Column, value)
 	}
@@ -1901,6 +3475,44 @@ func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(st
 	return nil
 }
 
+// fieldValue returns the value of the field as it should be passed to the database driver.
+func fieldValue(d string, fi *FieldSpec) (driver.Value, error) {
+	value := fi.Value
+	if fi.Type != field.TypeJSON {
+		return value, nil
+	}
+	// Sensitive values are marshaled without their wrapper,
+	// and wrapped again after marshaling.
+	s, sensitive := value.(dialect.Sensitive)
+	if sensitive {
+		value = s.V
+	}
+	switch v := value.(type) {
+	case json.RawMessage:
+		// Already encoded.
+	case sqlarray.Value:
+		// Array values are encoded by the dialect, as they are
+		// stored in native array columns in PostgreSQL.
+		ev, err := v.Encode(d)
+		if err != nil {
+			return nil, fmt.Errorf("encode array value for column %s: %w", fi.Column, err)
+		}
+		value = ev
+	default:
+		buf, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("marshal value for column %s: %w", fi.Column, err)
+		}
+		// If the underlying driver does not support JSON types,
+		// driver.DefaultParameterConverter will convert it to uint8.
+		value = json.RawMessage(buf)
+	}
+	if sensitive {
+		value = dialect.Sensitive{V: value}
+	}
+	return value, nil
+}
+
 // insertLastID invokes the insert query on the transaction and returns the LastInsertID.
 func (c *creator) insertLastID(ctx context.Context, insert *sql.InsertBuilder) error {
 	query, args, err := insert.QueryErr()
@@ -1914,6 +3526,15 @@ func (c *creator) insertLastID(ctx context.Context, insert *sql.InsertBuilder) e
 			return err
 		}
 		defer rows.Close()
+		if hasGenerated(c.CreateSpec) {
+			if !rows.Next() {
+				if err := rows.Err(); err != nil {
+					return err
+				}
+				return &NotFoundError{table: c.Table, id: c.ID.Value}
+			}
+			return scanReturning(rows, c.CreateSpec)
+		}
 		switch _, ok := c.ID.Value.(field.ValueScanner); {
 		case ok:
 			// If the ID implements the sql.Scanner
@@ -1950,7 +3571,7 @@ func (c *creator) insertLastID(ctx context.Context, insert *sql.InsertBuilder) e
 }
 
 // insertLastIDs invokes the batch insert query on the transaction and returns the LastInsertID of all entities.
-func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder, nodes []*CreateSpec) error {
 	query, args, err := insert.QueryErr()
 	if err != nil {
 		return err
@@ -1963,26 +3584,8 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 		}
 		defer rows.Close()
 		for i := 0; rows.Next(); i++ {
-			node := c.Nodes[i]
-			switch _, ok := node.ID.Value.(field.ValueScanner); {
-			case ok:
-				// If the ID implements the sql.Scanner
-				// interface it should be a pointer type.
-				if err := rows.Scan(node.ID.Value); err != nil {
-					return err
-				}
-			case node.ID.Type.Numeric():
-				// Normalize the type to int64 to make it looks
-				// like LastInsertId.
-				var id int64
-				if err := rows.Scan(&id); err != nil {
-					return err
-				}
-				node.ID.Value = id
-			default:
-				if err := rows.Scan(&node.ID.Value); err != nil {
-					return err
-				}
+			if err := scanReturning(rows, nodes[i]); err != nil {
+				return err
 			}
 		}
 		return rows.Err()
@@ -1994,7 +3597,7 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 	}
 	// If the ID field is not numeric (e.g. string),
 	// there is no way to scan the LAST_INSERT_ID.
-	if len(c.Nodes) > 0 && c.Nodes[0].ID.Type.Numeric() {
+	if len(nodes) > 0 && nodes[0].ID.Type.Numeric() {
 		id, err := res.LastInsertId()
 		if err != nil {
 			return err
@@ -2005,13 +3608,41 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 		}
 		// Assume the ID field is AUTO_INCREMENT
 		// if its type is numeric.
-		for i := 0; int64(i) < affected && i < len(c.Nodes); i++ {
-			c.Nodes[i].ID.Value = id + int64(i)
+		for i := 0; int64(i) < affected && i < len(nodes); i++ {
+			nodes[i].ID.Value = id + int64(i)
 		}
 	}
 	return nil
 }
 
+// scanRows scans all rows using the given functions,
+// and returns the number of rows that were scanned.
+func scanRows(rows *sql.Rows, scan func([]string) ([]any, error), assign func([]string, []any) error) (int, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for ; rows.Next(); n++ {
+		values, err := scan(columns)
+		if err != nil {
+			return 0, err
+		}
+		for i, v := range values {
+			if _, ok := v.(*sql.UnknownType); ok {
+				values[i] = sql.ScanTypeOf(rows, i)
+			}
+		}
+		if err := rows.Scan(values...); err != nil {
+			return 0, fmt.Errorf("failed scanning rows: %w", err)
+		}
+		if err := assign(columns, values); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+
 // rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
 func rollback(tx dialect.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
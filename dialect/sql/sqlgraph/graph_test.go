@@ -10,11 +10,13 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlarray"
 	"entgo.io/ent/schema/field"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -442,6 +444,337 @@ JOIN
 	}
 }
 
+func TestRecursiveNeighbors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *Step
+		spec      *RecursiveSpec
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name: "O2M/descendants",
+			input: NewStep(
+				From("categories", "id", sql.Select().From(sql.Table("categories")).Where(sql.EQ("name", "root"))),
+				To("categories", "id"),
+				Edge(O2M, false, "categories", "parent_id"),
+			),
+			spec: &RecursiveSpec{},
+			wantQuery: `
+WITH RECURSIVE "traverse"("id") AS
+  (SELECT "categories"."id"
+   FROM "categories"
+   JOIN
+     (SELECT "categories"."id"
+      FROM "categories"
+      WHERE "name" = $1) AS "t1" ON "categories"."parent_id" = "t1"."id"
+   UNION SELECT "categories"."id"
+   FROM "categories"
+   JOIN "traverse" AS "t1" ON "categories"."parent_id" = "t1"."id")
+SELECT "categories"."id"
+FROM "categories"
+WHERE "categories"."id" IN
+    (SELECT "traverse"."id"
+     FROM "traverse")`,
+			wantArgs: []any{"root"},
+		},
+		{
+			name: "O2M/max-depth",
+			input: NewStep(
+				From("categories", "id", 1),
+				To("categories", "id"),
+				Edge(O2M, false, "categories", "parent_id"),
+			),
+			spec: &RecursiveSpec{MaxDepth: 2},
+			wantQuery: `
+WITH RECURSIVE "traverse"("id", "depth") AS
+  (SELECT "categories"."id", 1
+   FROM "categories"
+   WHERE "parent_id" = $1
+   UNION SELECT "categories"."id", "t1"."depth" + 1
+   FROM "categories"
+   JOIN "traverse" AS "t1" ON "categories"."parent_id" = "t1"."id"
+   WHERE "t1"."depth" < $2)
+SELECT "categories"."id"
+FROM "categories"
+WHERE "categories"."id" IN
+    (SELECT "traverse"."id"
+     FROM "traverse")`,
+			wantArgs: []any{1, 2},
+		},
+		{
+			name: "O2M/max-depth/predicate",
+			input: NewStep(
+				From("categories", "id", 1),
+				To("categories", "id"),
+				Edge(O2M, false, "categories", "parent_id"),
+			),
+			spec: &RecursiveSpec{MaxDepth: 2, Predicate: func(s *sql.Selector) {
+				s.Where(sql.IsNull(s.C("delete_time")))
+			}},
+			wantQuery: `
+WITH RECURSIVE "traverse"("id", "depth") AS
+  (SELECT "categories"."id", 1
+   FROM "categories"
+   WHERE "parent_id" = $1
+     AND "categories"."id" IN
+       (SELECT "categories"."id"
+        FROM "categories"
+        WHERE "categories"."delete_time" IS NULL)
+   UNION SELECT "categories"."id", "t1"."depth" + 1
+   FROM "categories"
+   JOIN "traverse" AS "t1" ON "categories"."parent_id" = "t1"."id"
+   WHERE "categories"."id" IN
+       (SELECT "categories"."id"
+        FROM "categories"
+        WHERE "categories"."delete_time" IS NULL)
+     AND "t1"."depth" < $2)
+SELECT "categories"."id"
+FROM "categories"
+WHERE "categories"."id" IN
+    (SELECT "traverse"."id"
+     FROM "traverse")`,
+			wantArgs: []any{1, 2},
+		},
+		{
+			name: "M2O/ancestors/depth",
+			input: NewStep(
+				From("categories", "id", 1),
+				To("categories", "id"),
+				Edge(M2O, true, "categories", "parent_id"),
+			),
+			spec: &RecursiveSpec{Depth: "depth"},
+			wantQuery: `
+WITH RECURSIVE "reachable"("id") AS
+  (SELECT "categories"."id"
+   FROM "categories"
+   JOIN
+     (SELECT "parent_id"
+      FROM "categories"
+      WHERE "id" = $1) AS "t1" ON "categories"."id" = "t1"."parent_id"
+   UNION SELECT "categories"."parent_id"
+   FROM "categories"
+   JOIN "reachable" AS "t1" ON "categories"."id" = "t1"."id"
+   WHERE "categories"."parent_id" IS NOT NULL),
+               "traverse"("id", "depth") AS
+  (SELECT "categories"."id", 1
+   FROM "categories"
+   JOIN
+     (SELECT "parent_id"
+      FROM "categories"
+      WHERE "id" = $2) AS "t1" ON "categories"."id" = "t1"."parent_id"
+   UNION SELECT "categories"."parent_id", "t1"."depth" + 1
+   FROM "categories"
+   JOIN "traverse" AS "t1" ON "categories"."id" = "t1"."id"
+   WHERE "categories"."parent_id" IS NOT NULL
+     AND "t1"."depth" <
+       (SELECT COUNT(*)
+        FROM "reachable"))
+SELECT "categories"."id", "depths"."depth" AS "depth"
+FROM "categories"
+JOIN
+  (SELECT "traverse"."id", MIN("traverse"."depth") AS "depth"
+   FROM "traverse"
+   GROUP BY "traverse"."id") AS "depths" ON "categories"."id" = "depths"."id"`,
+			wantArgs: []any{1, 1},
+		},
+		{
+			name: "M2M/max-depth/depth",
+			input: NewStep(
+				From("users", "id", sql.Select().From(sql.Table("users")).Where(sql.EQ("name", "a8m"))),
+				To("users", "id"),
+				Edge(M2M, false, "user_following", "user_id", "follower_id"),
+			),
+			spec: &RecursiveSpec{MaxDepth: 3, Depth: "level"},
+			wantQuery: `
+WITH RECURSIVE "traverse"("id", "depth") AS
+  (SELECT "users"."id", 1
+   FROM "users"
+   JOIN
+     (SELECT "user_following"."follower_id"
+      FROM "user_following"
+      JOIN
+        (SELECT "users"."id"
+         FROM "users"
+         WHERE "name" = $1) AS "t1" ON "user_following"."user_id" = "t1"."id") AS "t1" ON "users"."id" = "t1"."follower_id"
+   UNION SELECT "user_following"."follower_id", "t1"."depth" + 1
+   FROM "user_following"
+   JOIN "traverse" AS "t1" ON "user_following"."user_id" = "t1"."id"
+   WHERE "t1"."depth" < $2)
+SELECT "users"."id", "depths"."depth" AS "level"
+FROM "users"
+JOIN
+  (SELECT "traverse"."id", MIN("traverse"."depth") AS "depth"
+   FROM "traverse"
+   GROUP BY "traverse"."id") AS "depths" ON "users"."id" = "depths"."id"`,
+			wantArgs: []any{"a8m", 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := RecursiveNeighbors("postgres", tt.input, tt.spec)
+			selector.Select(selector.C("id"))
+			SelectDepth(tt.spec)(selector)
+			query, args := selector.Query()
+			tt.wantQuery = strings.Join(strings.Fields(tt.wantQuery), " ")
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	const query = "SELECT `adjacency`.`src`, `adjacency`.`dst` FROM " +
+		"(SELECT `categories`.`parent_id` AS `src`, `categories`.`id` AS `dst` FROM `categories` WHERE `categories`.`parent_id` IS NOT NULL) AS `adjacency` " +
+		"WHERE `adjacency`.`src` IN "
+	step := NewStep(
+		From("categories", "id"),
+		To("categories", "id"),
+		Edge(O2M, false, "categories", "parent_id"),
+	)
+	t.Run("Found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(query + "(?)")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"src", "dst"}).
+				AddRow(1, 2).
+				AddRow(1, 3))
+		// The search stops at the level of the target vertex.
+		mock.ExpectQuery(escape(query + "(?, ?)")).
+			WithArgs(2, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"src", "dst"}).
+				AddRow(2, 5).
+				AddRow(3, 4).
+				AddRow(3, 6))
+		path, err := ShortestPath(context.Background(), sql.OpenDB(dialect.MySQL, db), 1, 4, step)
+		require.NoError(t, err)
+		require.Equal(t, []driver.Value{1, 3, 4}, path)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(query + "(?)")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"src", "dst"}).
+				AddRow(1, 2))
+		// Visited vertices are not expanded again.
+		mock.ExpectQuery(escape(query + "(?)")).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"src", "dst"}).
+				AddRow(2, 1).
+				AddRow(2, 2))
+		_, err = ShortestPath(context.Background(), sql.OpenDB(dialect.MySQL, db), 1, 4, step)
+		var nf *NotFoundError
+		require.True(t, errors.As(err, &nf))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Same", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		path, err := ShortestPath(context.Background(), sql.OpenDB(dialect.MySQL, db), 1, 1, step)
+		require.NoError(t, err)
+		require.Equal(t, []driver.Value{1}, path)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Same/Predicate", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape("SELECT 1 FROM `categories` WHERE `categories`.`id` = ? AND `categories`.`deleted_at` IS NULL LIMIT 1")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"1"}))
+		_, err = ShortestPathWith(context.Background(), sql.OpenDB(dialect.MySQL, db), 1, 1, sql.FieldIsNull("deleted_at"), step)
+		var nf *NotFoundError
+		require.True(t, errors.As(err, &nf))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReachable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT `adjacency`.`src`, `adjacency`.`dst` FROM " +
+		"(SELECT `user_friends`.`user_id` AS `src`, `user_friends`.`friend_id` AS `dst` FROM `user_friends` " +
+		"UNION ALL SELECT `users`.`id` AS `src`, `users`.`parent_id` AS `dst` FROM `users` WHERE `users`.`parent_id` IS NOT NULL) AS `adjacency` " +
+		"WHERE `adjacency`.`src` IN (?)")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"src", "dst"}).AddRow(1, 2))
+	ok, err := Reachable(context.Background(), sql.OpenDB(dialect.MySQL, db), 1, 2,
+		NewStep(From("users", "id"), To("users", "id"), Edge(M2M, false, "user_friends", "user_id", "friend_id")),
+		NewStep(From("users", "id"), To("users", "id"), Edge(M2O, false, "users", "parent_id")),
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("Predicate", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		const adjacency = "(SELECT `users`.`id` AS `src`, `users`.`parent_id` AS `dst` FROM `users` WHERE `users`.`parent_id` IS NOT NULL) AS `adjacency`"
+		const matched = "(SELECT `users`.`id` FROM `users` WHERE `users`.`tenant_id` = ?)"
+		mock.ExpectQuery(escape("SELECT `adjacency`.`src`, `adjacency`.`dst` FROM " + adjacency + " " +
+			"WHERE (`adjacency`.`src` IN " + matched + " AND `adjacency`.`dst` IN " + matched + ") AND `adjacency`.`src` IN (?)")).
+			WithArgs(10, 10, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"src", "dst"}))
+		ok, err := ReachableWith(context.Background(), sql.OpenDB(dialect.MySQL, db), 1, 2, sql.FieldEQ("tenant_id", 10),
+			NewStep(From("users", "id"), To("users", "id"), Edge(M2O, false, "users", "parent_id")),
+		)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPolymorphicNeighbors(t *testing.T) {
+	step := func(v any) *Step {
+		return NewStep(
+			From("comments", "id", v),
+			To("posts", "id"),
+			Edge(M2O, false, "comments", "target_id"),
+			Polymorphic("target_type", "Post"),
+		)
+	}
+	t.Run("Neighbors", func(t *testing.T) {
+		query, args := Neighbors(dialect.MySQL, step(1)).Query()
+		require.Equal(t, "SELECT * FROM `posts` JOIN (SELECT `target_id` FROM `comments` WHERE `id` = ? AND `target_type` = ?) AS `t1` ON `posts`.`id` = `t1`.`target_id`", query)
+		require.Equal(t, []any{1, "Post"}, args)
+	})
+	t.Run("SetNeighbors", func(t *testing.T) {
+		set := sql.Select().From(sql.Table("comments")).Where(sql.EQ("text", "hello"))
+		query, args := SetNeighbors(dialect.MySQL, step(set)).Query()
+		require.Equal(t, "SELECT * FROM `posts` JOIN (SELECT `comments`.`target_id` FROM `comments` WHERE `text` = ? AND `comments`.`target_type` = ?) AS `t1` ON `posts`.`id` = `t1`.`target_id`", query)
+		require.Equal(t, []any{"hello", "Post"}, args)
+	})
+	t.Run("HasNeighbors", func(t *testing.T) {
+		s := sql.Select("*").From(sql.Table("comments"))
+		HasNeighbors(s, step(nil))
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `comments` WHERE `comments`.`target_id` IS NOT NULL AND `comments`.`target_type` = ?", query)
+		require.Equal(t, []any{"Post"}, args)
+	})
+	t.Run("HasNeighborsWith", func(t *testing.T) {
+		s := sql.Select("*").From(sql.Table("comments"))
+		HasNeighborsWith(s, step(nil), func(s *sql.Selector) {
+			s.Where(sql.EQ("title", "ent"))
+		})
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `comments` WHERE `comments`.`target_type` = ? AND EXISTS (SELECT `posts`.`id` FROM `posts` WHERE `comments`.`target_id` = `posts`.`id` AND `title` = ?)", query)
+		require.Equal(t, []any{"Post", "ent"}, args)
+	})
+	t.Run("Inverse", func(t *testing.T) {
+		s := NewStep(
+			From("posts", "id", 1),
+			To("comments", "id"),
+			Edge(O2M, true, "comments", "target_id"),
+			Polymorphic("target_type", "Post"),
+		)
+		query, args := Neighbors(dialect.MySQL, s).Query()
+		require.Equal(t, "SELECT * FROM `comments` WHERE `target_id` = ? AND `target_type` = ?", query)
+		require.Equal(t, []any{1, "Post"}, args)
+	})
+}
+
 func TestHasNeighbors(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1167,6 +1500,22 @@ func TestCreateNode(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
+		{
+			name: "fields/sensitive",
+			spec: &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				Fields: []*FieldSpec{
+					{Column: "password", Type: field.TypeString, Value: dialect.Sensitive{V: "pass"}},
+					{Column: "tokens", Type: field.TypeJSON, Value: dialect.Sensitive{V: []string{"a"}}},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(escape("INSERT INTO `users` (`password`, `tokens`) VALUES (?, ?)")).
+					WithArgs("pass", []byte(`["a"]`)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
 		{
 			name: "edges/m2o",
 			spec: &CreateSpec{
@@ -1468,6 +1817,127 @@ func TestCreateNode(t *testing.T) {
 	}
 }
 
+func TestCreateNode_Generated(t *testing.T) {
+	names := make(map[int]string)
+	spec := func(i int, first string) *CreateSpec {
+		return &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "first", Type: field.TypeString, Value: first},
+				{Column: "last", Type: field.TypeString, Value: "Mashraki"},
+			},
+			Generated: &GeneratedSpec{
+				Columns: []string{"full_name"},
+				ScanValues: func(columns []string) ([]any, error) {
+					return []any{&sql.NullString{}}, nil
+				},
+				Assign: func(columns []string, values []any) error {
+					names[i] = values[0].(*sql.NullString).String
+					return nil
+				},
+			},
+		}
+	}
+	t.Run("MySQL", func(t *testing.T) {
+		clear(names)
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		// Generated columns are read in the insert transaction.
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`first`, `last`) VALUES (?, ?)")).
+			WithArgs("Ariel", "Mashraki").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(escape("SELECT `id`, `full_name` FROM `users` WHERE `id` IN (?)")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name"}).AddRow(1, "Ariel Mashraki"))
+		mock.ExpectCommit()
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.MySQL, db), spec(0, "Ariel"))
+		require.NoError(t, err)
+		require.Equal(t, "Ariel Mashraki", names[0])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL/Batch", func(t *testing.T) {
+		clear(names)
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`first`, `last`) VALUES (?, ?), (?, ?)")).
+			WithArgs("Ariel", "Mashraki", "Rotem", "Mashraki").
+			WillReturnResult(sqlmock.NewResult(10, 2))
+		// One query for all nodes, in any order.
+		mock.ExpectQuery(escape("SELECT `id`, `full_name` FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(10, 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name"}).
+				AddRow(11, "Rotem Mashraki").
+				AddRow(10, "Ariel Mashraki"))
+		mock.ExpectCommit()
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.MySQL, db), &BatchCreateSpec{
+			Nodes: []*CreateSpec{spec(0, "Ariel"), spec(1, "Rotem")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[int]string{0: "Ariel Mashraki", 1: "Rotem Mashraki"}, names)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Postgres", func(t *testing.T) {
+		clear(names)
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("first", "last") VALUES ($1, $2) RETURNING "id", "full_name"`)).
+			WithArgs("Ariel", "Mashraki").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name"}).AddRow(1, "Ariel Mashraki"))
+		node := spec(0, "Ariel")
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), node)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), node.ID.Value)
+		require.Equal(t, "Ariel Mashraki", names[0])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Postgres/Batch", func(t *testing.T) {
+		clear(names)
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("first", "last") VALUES ($1, $2), ($3, $4) RETURNING "id", "full_name"`)).
+			WithArgs("Ariel", "Mashraki", "Rotem", "Mashraki").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name"}).
+				AddRow(10, "Ariel Mashraki").
+				AddRow(11, "Rotem Mashraki"))
+		nodes := []*CreateSpec{spec(0, "Ariel"), spec(1, "Rotem")}
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), &BatchCreateSpec{Nodes: nodes})
+		require.NoError(t, err)
+		require.Equal(t, int64(11), nodes[1].ID.Value)
+		require.Equal(t, map[int]string{0: "Ariel Mashraki", 1: "Rotem Mashraki"}, names)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateNode_Array(t *testing.T) {
+	spec := func() *CreateSpec {
+		return &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "tags", Type: field.TypeJSON, Value: sqlarray.Value{V: []string{"a", "b"}}},
+			},
+		}
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape(`INSERT INTO "users" ("tags") VALUES ($1) RETURNING "id"`)).
+		WithArgs(`{"a","b"}`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	err = CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), spec())
+	require.NoError(t, err)
+
+	// Other dialects fall back to the JSON representation.
+	mock.ExpectExec(escape("INSERT INTO `users` (`tags`) VALUES (?)")).
+		WithArgs([]byte(`["a","b"]`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	err = CreateNode(context.Background(), sql.OpenDB(dialect.MySQL, db), spec())
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestBatchCreate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1670,6 +2140,70 @@ func TestBatchCreate(t *testing.T) {
 	}
 }
 
+func TestBatchCreate_Chunks(t *testing.T) {
+	spec := func(n int) *BatchCreateSpec {
+		spec := &BatchCreateSpec{BatchSize: 2}
+		for i := 0; i < n; i++ {
+			spec.Nodes = append(spec.Nodes, &CreateSpec{
+				Table:  "users",
+				ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+				Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: strconv.Itoa(i)}},
+			})
+		}
+		return spec
+	}
+	ids := func(spec *BatchCreateSpec) (ids []driver.Value) {
+		for _, n := range spec.Nodes {
+			ids = append(ids, n.ID.Value)
+		}
+		return ids
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?), (?)")).
+		WithArgs("0", "1").
+		WillReturnResult(sqlmock.NewResult(10, 2))
+	mock.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?)")).
+		WithArgs("2").
+		WillReturnResult(sqlmock.NewResult(20, 1))
+	mock.ExpectCommit()
+	s := spec(3)
+	err = BatchCreate(context.Background(), sql.OpenDB(dialect.MySQL, db), s)
+	require.NoError(t, err)
+	require.Equal(t, []driver.Value{int64(10), int64(11), int64(20)}, ids(s))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBatchCreate_MySQLPacket(t *testing.T) {
+	spec := &BatchCreateSpec{}
+	for i := 0; i < 5; i++ {
+		spec.Nodes = append(spec.Nodes, &CreateSpec{
+			Table:  "users",
+			ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: strings.Repeat(strconv.Itoa(i), mysqlMaxPacket/2-100)}},
+		})
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	// Each statement is bounded by the packet size, and holds at least one row.
+	for i, n := range []int{2, 2, 1} {
+		mock.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?)" + strings.Repeat(", (?)", n-1))).
+			WillReturnResult(sqlmock.NewResult(int64(i*10), int64(n)))
+	}
+	mock.ExpectCommit()
+	err = BatchCreate(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Other dialects are bounded only by the placeholder limit.
+	c := &batchCreator{BatchCreateSpec: &BatchCreateSpec{}}
+	values := []map[string]driver.Value{{"name": strings.Repeat("a", mysqlMaxPacket)}, {"name": strings.Repeat("b", mysqlMaxPacket)}}
+	require.Equal(t, [][2]int{{0, 2}}, c.chunks(dialect.Postgres, []string{"name"}, values))
+	require.Equal(t, [][2]int{{0, 1}, {1, 2}}, c.chunks(dialect.MySQL, []string{"name"}, values))
+}
+
 type user struct {
 	id    int
 	age   int
@@ -1784,6 +2318,37 @@ func TestUpdateNode(t *testing.T) {
 			},
 			wantUser: &user{name: "Ariel", age: 30, id: 1},
 		},
+		{
+			name: "fields/composite_id",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age", "best_friend_id"},
+					CompositeID: []*FieldSpec{
+						{Column: "id", Type: field.TypeInt, Value: 1},
+						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "best_friend_id", Type: field.TypeInt, Value: 2},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `best_friend_id` = ? WHERE `id` = ? AND `name` = ? AND `age` = ?")).
+					WithArgs(2, 1, "Ariel", 30).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age`, `best_friend_id` FROM `users` WHERE `id` = ? AND `name` = ? AND `age` = ?")).
+					WithArgs(1, "Ariel", 30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age", "best_friend_id"}).
+						AddRow(1, "Ariel", 30, 2))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "Ariel", age: 30, id: 1, bfID: 2},
+		},
 		{
 			name: "fields/add_set_clear",
 			spec: &UpdateSpec{
@@ -2076,6 +2641,197 @@ func TestUpdateNode(t *testing.T) {
 	}
 }
 
+func TestUpdateNode_Version(t *testing.T) {
+	spec := func() *UpdateSpec {
+		spec := NewUpdateSpec("users", []string{"id", "name", "age"}, NewFieldSpec("id", field.TypeInt))
+		spec.Node.ID.Value = 1
+		spec.SetField("name", field.TypeString, "a8m")
+		spec.SetVersion("version", field.TypeInt, 2)
+		return spec
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec(escape("UPDATE `users` SET `name` = ?, `version` = COALESCE(`users`.`version`, 0) + ? WHERE `id` = ? AND `version` = ?")).
+		WithArgs("a8m", 1, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err = UpdateNode(context.Background(), sql.OpenDB("", db), spec())
+	require.NoError(t, err)
+
+	// Record exists, but its version was changed.
+	mock.ExpectBegin()
+	mock.ExpectExec(escape("UPDATE `users` SET `name` = ?, `version` = COALESCE(`users`.`version`, 0) + ? WHERE `id` = ? AND `version` = ?")).
+		WithArgs("a8m", 1, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ?)")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+	err = UpdateNode(context.Background(), sql.OpenDB("", db), spec())
+	require.True(t, IsStaleObject(err))
+
+	// Record does not exist.
+	mock.ExpectBegin()
+	mock.ExpectExec(escape("UPDATE `users` SET `name` = ?, `version` = COALESCE(`users`.`version`, 0) + ? WHERE `id` = ? AND `version` = ?")).
+		WithArgs("a8m", 1, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ?)")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectRollback()
+	err = UpdateNode(context.Background(), sql.OpenDB("", db), spec())
+	require.False(t, IsStaleObject(err))
+	var nf *NotFoundError
+	require.ErrorAs(t, err, &nf)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Bulk updates increment the version.
+	mock.ExpectExec(escape("UPDATE `users` SET `version` = COALESCE(`users`.`version`, 0) + ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	us := NewUpdateSpec("users", []string{"id", "name", "age"}, NewFieldSpec("id", field.TypeInt))
+	us.SetVersion("version", field.TypeInt, nil)
+	affected, err := UpdateNodes(context.Background(), sql.OpenDB("", db), us)
+	require.NoError(t, err)
+	require.Equal(t, 3, affected)
+}
+
+func TestBatchUpdate(t *testing.T) {
+	type user struct {
+		id   int64
+		name string
+	}
+	specs := func(nodes []*user, f func(int, *UpdateSpec)) *BatchUpdateSpec {
+		spec := &BatchUpdateSpec{}
+		for i, n := range nodes {
+			us := NewUpdateSpec("users", []string{"id", "name"}, NewFieldSpec("id", field.TypeInt))
+			us.Node.ID.Value = i + 1
+			us.ScanValues = func([]string) ([]any, error) {
+				return []any{&sql.NullInt64{}, &sql.NullString{}}, nil
+			}
+			us.Assign = func(_ []string, values []any) error {
+				n.id = values[0].(*sql.NullInt64).Int64
+				n.name = values[1].(*sql.NullString).String
+				return nil
+			}
+			f(i, us)
+			spec.Nodes = append(spec.Nodes, us)
+		}
+		return spec
+	}
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("UPDATE `users` SET `name` = CASE `users`.`id` WHEN ? THEN ? WHEN ? THEN ? END, `age` = COALESCE(`users`.`age`, 0) + CASE `users`.`id` WHEN ? THEN ? WHEN ? THEN ? END WHERE `users`.`id` IN (?, ?)")).
+			WithArgs(1, "a8m", 2, "nati", 1, 1, 2, 2, 1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "nati").AddRow(1, "a8m"))
+		// Nodes with a different shape are updated by a separate statement.
+		mock.ExpectExec(escape("UPDATE `users` SET `age` = NULL WHERE `users`.`id` IN (?)")).
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `id` IN (?)")).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "ariel"))
+		mock.ExpectCommit()
+		nodes := []*user{{}, {}, {}}
+		err = BatchUpdate(context.Background(), sql.OpenDB(dialect.SQLite, db), specs(nodes, func(i int, us *UpdateSpec) {
+			if i == 2 {
+				us.ClearField("age", field.TypeInt)
+				return
+			}
+			us.SetField("name", field.TypeString, []string{"a8m", "nati"}[i])
+			us.AddField("age", field.TypeInt, i+1)
+		}))
+		require.NoError(t, err)
+		require.Equal(t, []*user{{1, "a8m"}, {2, "nati"}, {3, "ariel"}}, nodes)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`SELECT "id", "version" FROM "users" WHERE "id" IN ($1, $2) FOR UPDATE`)).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 1).AddRow(2, 3))
+		mock.ExpectExec(escape(`UPDATE "users" SET "name" = "batch"."c0", "version" = COALESCE("users"."version", 0) + 1 FROM (SELECT "id", "name", "version" FROM "users" WHERE FALSE UNION ALL VALUES ($1, $2, $3), ($4, $5, $6)) AS "batch"("id", "c0", "c1") WHERE "users"."id" = "batch"."id" AND "users"."version" = "batch"."c1"`)).
+			WithArgs(1, "a8m", 1, 2, "nati", 3).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(escape(`SELECT "id", "name" FROM "users" WHERE "id" IN ($1, $2)`)).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "nati"))
+		mock.ExpectCommit()
+		nodes := []*user{{}, {}}
+		err = BatchUpdate(context.Background(), sql.OpenDB(dialect.Postgres, db), specs(nodes, func(i int, us *UpdateSpec) {
+			us.SetField("name", field.TypeString, []string{"a8m", "nati"}[i])
+			us.SetVersion("version", field.TypeInt, 2*i+1)
+		}))
+		require.NoError(t, err)
+		require.Equal(t, []*user{{1, "a8m"}, {2, "nati"}}, nodes)
+
+		// One of the versions does not match, and one of the nodes was deleted.
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`SELECT "id", "version" FROM "users" WHERE "id" IN ($1, $2, $3) FOR UPDATE`)).
+			WithArgs(1, 2, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 1).AddRow(2, []byte("4")))
+		mock.ExpectRollback()
+		err = BatchUpdate(context.Background(), sql.OpenDB(dialect.Postgres, db), specs([]*user{{}, {}, {}}, func(i int, us *UpdateSpec) {
+			us.SetField("name", field.TypeString, []string{"a8m", "nati", "ariel"}[i])
+			us.SetVersion("version", field.TypeInt, 2*i+1)
+		}))
+		require.True(t, IsStaleObject(err))
+		require.EqualError(t, err, "record with id 2 in table users was modified (expected version 3)\nrecord with id 3 not found in table users")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("UPDATE `users` JOIN (SELECT ? AS `id`, ? AS `c0` UNION ALL SELECT ?, ?) AS `batch` ON `users`.`id` = `batch`.`id` AND ((`users`.`id` = ? AND `users`.`tenant` = ?) OR `users`.`id` IN (?)) SET `users`.`name` = `batch`.`c0`")).
+			WithArgs(1, "a8m", 2, "nati", 1, "t1", 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+		mock.ExpectRollback()
+		nodes := []*user{{}, {}}
+		err = BatchUpdate(context.Background(), sql.OpenDB(dialect.MySQL, db), specs(nodes, func(i int, us *UpdateSpec) {
+			us.SetField("name", field.TypeString, []string{"a8m", "nati"}[i])
+			if i == 0 {
+				us.Predicate = func(s *sql.Selector) {
+					s.Where(sql.EQ(s.C("tenant"), "t1"))
+				}
+			}
+		}))
+		var nf *NotFoundError
+		require.ErrorAs(t, err, &nf)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Chunks", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		n := maxPlaceholders(dialect.MySQL)/2 + 1
+		spec := &BatchUpdateSpec{}
+		for i := 0; i < n; i++ {
+			us := NewUpdateSpec("users", []string{"id"}, NewFieldSpec("id", field.TypeInt))
+			us.Node.ID.Value = i
+			us.SetField("name", field.TypeString, "a8m")
+			spec.Nodes = append(spec.Nodes, us)
+		}
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `users` JOIN").WillReturnResult(sqlmock.NewResult(0, int64(n-1)))
+		mock.ExpectExec("UPDATE `users` JOIN").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		err = BatchUpdate(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestExecUpdateNode(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2377,6 +3133,53 @@ func TestUpdateNodes(t *testing.T) {
 	}
 }
 
+func TestUpdateNodes_Returning(t *testing.T) {
+	var names []string
+	spec := func() *UpdateSpec {
+		names = nil
+		spec := NewUpdateSpec("users", []string{"id", "name"}, NewFieldSpec("id", field.TypeInt))
+		spec.Predicate = func(s *sql.Selector) {
+			s.Where(sql.EQ("age", 30))
+		}
+		spec.SetField("name", field.TypeString, "a8m")
+		spec.ScanValues = func([]string) ([]any, error) {
+			return []any{&sql.NullInt64{}, &sql.NullString{}}, nil
+		}
+		spec.Assign = func(_ []string, values []any) error {
+			names = append(names, values[1].(*sql.NullString).String)
+			return nil
+		}
+		return spec
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("UPDATE `users` SET `name` = ? WHERE `age` = ? RETURNING `id`, `name`")).
+		WithArgs("a8m", 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "a8m"))
+	affected, err := UpdateNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), spec())
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+	require.Equal(t, []string{"a8m", "a8m"}, names)
+
+	// MySQL locks the rows before the update, and queries them after it.
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `age` = ? FOR UPDATE")).
+		WithArgs(30).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectExec(escape("UPDATE `users` SET `name` = ? WHERE `id` IN (?, ?)")).
+		WithArgs("a8m", 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "a8m"))
+	mock.ExpectCommit()
+	affected, err = UpdateNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec())
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+	require.Equal(t, []string{"a8m", "a8m"}, names)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDeleteNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2392,6 +3195,54 @@ func TestDeleteNodes(t *testing.T) {
 	require.Equal(t, 2, affected)
 }
 
+func TestDeleteNodes_Returning(t *testing.T) {
+	var names []string
+	spec := func() *DeleteSpec {
+		names = nil
+		return &DeleteSpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "name"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ("age", 30))
+			},
+			ScanValues: func([]string) ([]any, error) {
+				return []any{&sql.NullInt64{}, &sql.NullString{}}, nil
+			},
+			Assign: func(_ []string, values []any) error {
+				names = append(names, values[1].(*sql.NullString).String)
+				return nil
+			},
+		}
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape(`DELETE FROM "users" WHERE "age" = $1 RETURNING "id", "name"`)).
+		WithArgs(30).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "nati"))
+	affected, err := DeleteNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), spec())
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+	require.Equal(t, []string{"a8m", "nati"}, names)
+
+	// MySQL selects and locks the rows before deleting them.
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `age` = ? FOR UPDATE")).
+		WithArgs(30).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+	mock.ExpectExec(escape("DELETE FROM `users` WHERE `age` = ?")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	affected, err = DeleteNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec())
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+	require.Equal(t, []string{"a8m"}, names)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDeleteNodesSchema(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2523,6 +3374,52 @@ func TestQueryNodesSchema(t *testing.T) {
 	require.Equal(t, &user{id: 3, age: 30, name: "a8m", edges: struct{ fk1, fk2 int }{1, 1}}, users[2])
 }
 
+func TestQueryNodes_Computed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT `users`.`id`, `users`.`name`, (LENGTH(`users`.`name`)) AS `age` FROM `users` WHERE (LENGTH(`users`.`name`)) > ? ORDER BY (LENGTH(`users`.`name`)) DESC")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}).
+			AddRow(1, "a8m", 3))
+	mock.ExpectQuery(escape("SELECT COUNT(`users`.`id`) FROM `users` WHERE (LENGTH(`users`.`name`)) > ?")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(1))
+	var (
+		users  []*user
+		length = func(s *sql.Selector) sql.Querier {
+			return sql.Expr("LENGTH(" + s.C("name") + ")")
+		}
+		spec = &QuerySpec{
+			Node: &NodeSpec{
+				Table:    "users",
+				Columns:  []string{"id", "name", "age"},
+				ID:       &FieldSpec{Column: "id", Type: field.TypeInt},
+				Computed: map[string]func(*sql.Selector) sql.Querier{"age": length},
+			},
+			Order:     sql.OrderByExpr(length, sql.OrderDesc()).ToFunc(),
+			Predicate: sql.ExprOp(length, sql.OpGT, 2),
+			ScanValues: func(columns []string) ([]any, error) {
+				u := &user{}
+				users = append(users, u)
+				return u.values(columns)
+			},
+			Assign: func(columns []string, values []any) error {
+				return users[len(users)-1].assign(columns, values)
+			},
+		}
+	)
+	err = QueryNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, &user{id: 1, age: 3, name: "a8m"}, users[0])
+
+	// Computed columns are not counted.
+	spec.Node.Columns = []string{"age"}
+	n, err := CountNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
 func TestQueryEdges(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2718,6 +3615,197 @@ func TestIsConstraintError(t *testing.T) {
 	}
 }
 
+func TestParseConstraintError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected *ConstraintError
+	}{
+		{
+			name: "MySQL Unique",
+			err:  errors.New(`insert node to table "users": Error 1062 (23000): Duplicate entry 'a8m@entgo.io' for key 'users.email'`),
+			expected: &ConstraintError{
+				Kind:  UniqueConstraint,
+				Name:  "email",
+				Table: "users",
+			},
+		},
+		{
+			name: "MySQL 5.7 Unique",
+			err:  errors.New(`Error 1062: Duplicate entry 'a8m' for key 'user_name_email'`),
+			expected: &ConstraintError{
+				Kind: UniqueConstraint,
+				Name: "user_name_email",
+			},
+		},
+		{
+			name: "MySQL FK",
+			err: errors.New("insert node to table \"pets\": Error 1452: Cannot add or update a child row: a foreign key" +
+				" constraint fails (`test`.`pets`, CONSTRAINT `pets_users_pets` FOREIGN KEY (`user_pets`) REFERENCES " +
+				"`users` (`id`) ON DELETE SET NULL)"),
+			expected: &ConstraintError{
+				Kind:    ForeignKeyConstraint,
+				Name:    "pets_users_pets",
+				Table:   "pets",
+				Columns: []string{"user_pets"},
+			},
+		},
+		{
+			name: "MySQL Check",
+			err:  errors.New(`insert node to table "users": Error 3819: Check constraint 'users_age_check' is violated.`),
+			expected: &ConstraintError{
+				Kind: CheckConstraint,
+				Name: "users_age_check",
+			},
+		},
+		{
+			name: "Postgres Unique",
+			err:  errors.New(`insert node to table "users": pq: duplicate key value violates unique constraint "users_email_key"`),
+			expected: &ConstraintError{
+				Kind: UniqueConstraint,
+				Name: "users_email_key",
+			},
+		},
+		{
+			name: "Postgres FK Insert",
+			err:  errors.New(`pq: insert or update on table "pets" violates foreign key constraint "pets_users_pets"`),
+			expected: &ConstraintError{
+				Kind:  ForeignKeyConstraint,
+				Name:  "pets_users_pets",
+				Table: "pets",
+			},
+		},
+		{
+			name: "Postgres FK Delete",
+			err:  errors.New(`pq: update or delete on table "group_infos" violates foreign key constraint "groups_group_infos_info" on table "groups"`),
+			expected: &ConstraintError{
+				Kind:  ForeignKeyConstraint,
+				Name:  "groups_group_infos_info",
+				Table: "groups",
+			},
+		},
+		{
+			name: "Postgres Check",
+			err:  errors.New(`pq: new row for relation "users" violates check constraint "users_age_check"`),
+			expected: &ConstraintError{
+				Kind:  CheckConstraint,
+				Name:  "users_age_check",
+				Table: "users",
+			},
+		},
+		{
+			name: "SQLite Unique",
+			err:  errors.New(`insert node to table "users": UNIQUE constraint failed: users.first, users.last`),
+			expected: &ConstraintError{
+				Kind:    UniqueConstraint,
+				Table:   "users",
+				Columns: []string{"first", "last"},
+			},
+		},
+		{
+			name:     "SQLite FK",
+			err:      errors.New(`FOREIGN KEY constraint failed`),
+			expected: &ConstraintError{Kind: ForeignKeyConstraint},
+		},
+		{
+			name:     "SQLite Unnamed Check",
+			err:      errors.New(`CHECK constraint failed: age >= 18`),
+			expected: &ConstraintError{Kind: CheckConstraint},
+		},
+		{
+			name: "SQLite Check",
+			err:  errors.New(`CHECK constraint failed: users_age_check`),
+			expected: &ConstraintError{
+				Kind: CheckConstraint,
+				Name: "users_age_check",
+			},
+		},
+		{
+			name: "Not Constraint",
+			err:  errors.New("database is locked"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := ParseConstraintError(tt.err)
+			if tt.expected == nil {
+				require.Nil(t, e)
+				return
+			}
+			require.NotNil(t, e)
+			require.Equal(t, tt.expected.Kind, e.Kind)
+			require.Equal(t, tt.expected.Name, e.Name)
+			require.Equal(t, tt.expected.Table, e.Table)
+			require.Equal(t, tt.expected.Columns, e.Columns)
+			require.Equal(t, tt.err.Error(), e.Error())
+			require.ErrorIs(t, e, tt.err)
+		})
+	}
+}
+
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "pq: error" }
+func (e sqlStateError) SQLState() string { return string(e) }
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "MySQL Deadlock",
+			err:      errors.New("Error 1213 (40001): Deadlock found when trying to get lock; try restarting transaction"),
+			expected: true,
+		},
+		{
+			name:     "MySQL Lock Wait Timeout",
+			err:      errors.New("Error 1205 (HY000): Lock wait timeout exceeded; try restarting transaction"),
+			expected: true,
+		},
+		{
+			name:     "Postgres Serialization Failure",
+			err:      errors.New("pq: could not serialize access due to concurrent update"),
+			expected: true,
+		},
+		{
+			name:     "Postgres Deadlock",
+			err:      errors.New("ERROR: deadlock detected (SQLSTATE 40P01)"),
+			expected: true,
+		},
+		{
+			name:     "Postgres SQLSTATE",
+			err:      fmt.Errorf("insert node to table %q: %w", "users", sqlStateError("40001")),
+			expected: true,
+		},
+		{
+			name:     "Postgres Unique",
+			err:      sqlStateError("23505"),
+			expected: false,
+		},
+		{
+			name:     "SQLite Busy",
+			err:      errors.New("database is locked"),
+			expected: true,
+		},
+		{
+			name:     "SQLite Unique",
+			err:      errors.New("UNIQUE constraint failed: users.name"),
+			expected: false,
+		},
+		{
+			name:     "Nil",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IsRetryableError(tt.err))
+		})
+	}
+}
+
 func TestLimitNeighbors(t *testing.T) {
 	t.Run("O2M", func(t *testing.T) {
 		const fk = "author_id"
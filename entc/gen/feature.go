@@ -159,6 +159,54 @@ var (
 		},
 	}
 
+	// FeatureHistory provides a feature-flag for recording the changes of
+	// schemas annotated with entsql.History in a history table.
+	FeatureHistory = Feature{
+		Name:        "sql/history",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Records the changes of annotated schemas in history tables and allows querying their state at a point in time",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "history.go"))
+		},
+	}
+
+	// FeatureOutbox provides a feature-flag for writing the mutations of schemas
+	// annotated with entsql.Outbox as events to a transactional outbox table.
+	FeatureOutbox = Feature{
+		Name:        "sql/outbox",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Writes the mutations of annotated schemas as events to an outbox table, and generates a relay for publishing them",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "outbox.go"))
+		},
+	}
+
+	// FeatureIter provides a feature-flag for generating methods that stream
+	// the query results using iterators.
+	FeatureIter = Feature{
+		Name:        "sql/iter",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates Iter and IterBatches methods on the query builders for streaming query results",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "iter.go"))
+		},
+	}
+
+	// FeatureTenancy provides a feature-flag for isolating the rows of schemas
+	// annotated with entsql.Tenant by the tenant that is stored in the context.
+	FeatureTenancy = Feature{
+		Name:        "sql/tenancy",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Filters the queries and restricts the mutations of annotated schemas to the tenant stored in the context",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "tenancy.go"))
+		},
+	}
+
 	// AllFeatures holds a list of all feature-flags.
 	AllFeatures = []Feature{
 		FeaturePrivacy,
@@ -174,6 +222,10 @@ var (
 		FeatureUpsert,
 		FeatureVersionedMigration,
 		FeatureGlobalID,
+		FeatureHistory,
+		FeatureOutbox,
+		FeatureIter,
+		FeatureTenancy,
 	}
 	// allFeatures includes all public and private features.
 	allFeatures = append(AllFeatures, featureMultiSchema)
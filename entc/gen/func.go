@@ -125,6 +125,14 @@ func fieldOps(f *Field) (ops []Op) {
 	case t == field.TypeJSON:
 	case t == field.TypeBool:
 		ops = boolOps
+	case f.IsComputed():
+		// Computed fields are compared by their expressions.
+		ops = numericOps
+	case f.IsEncrypted():
+		// Encrypted fields can be compared only by their blind indexes.
+		if f.HasBlindIndex() {
+			ops = enumOps
+		}
 	case t == field.TypeString && strings.ToLower(f.Name) != "id":
 		ops = stringOps
 		if f.HasGoType() && !f.ConvertedToBasic() && (f.Type.Valuer() || f.HasValueScanner()) {
@@ -166,6 +166,7 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	for i := range schemas {
 		g.addEdges(schemas[i])
 	}
+	check(g.compositeIDs(), "resolving composite identifiers")
 	for _, t := range g.Nodes {
 		check(g.resolve(t), "resolve %q relations", t.Name)
 	}
@@ -176,6 +177,14 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 		g.addIndexes(schemas[i])
 	}
 	check(g.edgeSchemas(), "resolving edges")
+	for _, t := range g.Nodes {
+		check(t.checkSoftDelete(), "invalid soft delete for schema %q", t.Name)
+		check(t.checkHistory(), "invalid history for schema %q", t.Name)
+		check(t.checkOutbox(), "invalid outbox for schema %q", t.Name)
+		check(t.checkTenancy(), "invalid tenancy for schema %q", t.Name)
+		check(t.checkVersion(), "invalid version field for schema %q", t.Name)
+	}
+	check(g.checkTenancy(), "invalid tenancy")
 	aliases(g)
 	g.defaults()
 	if c.Storage != nil && c.Storage.Init != nil {
@@ -189,6 +198,79 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	return
 }
 
+// HasSoftDelete reports if at least one of the nodes in the graph is soft-deletable.
+func (g *Graph) HasSoftDelete() bool {
+	for _, n := range g.Nodes {
+		if n.SoftDeleteField() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasHistory reports if at least one of the nodes in the graph is tracked by the history feature.
+func (g *Graph) HasHistory() bool {
+	for _, n := range g.Nodes {
+		if n.HistoryTable() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasVersion reports if at least one of the nodes in the graph has a version field.
+func (g *Graph) HasVersion() bool {
+	for _, n := range g.Nodes {
+		if n.VersionField() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// TenantType returns the type of the tenant fields in the graph, or nil
+// if none of the nodes in the graph is isolated by the "sql/tenancy" feature.
+func (g *Graph) TenantType() *field.TypeInfo {
+	for _, n := range g.Nodes {
+		if f := n.TenantField(); f != nil {
+			return f.Type
+		}
+	}
+	return nil
+}
+
+// checkTenancy ensures all tenant fields in the graph share the same type.
+func (g *Graph) checkTenancy() error {
+	typ := g.TenantType()
+	for _, n := range g.Nodes {
+		if f := n.TenantField(); f != nil && f.Type.String() != typ.String() {
+			return fmt.Errorf("tenant field %q of schema %q has type %s, but %s was used by other schemas", f.Name, n.Name, f.Type, typ)
+		}
+	}
+	return nil
+}
+
+// HasOutbox reports if at least one of the nodes in the graph writes its mutations to the outbox table.
+func (g *Graph) HasOutbox() bool {
+	for _, n := range g.Nodes {
+		if n.HasOutbox() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMutationTx reports if at least one of the nodes in the graph executes
+// its mutations in an internal transaction. See Type.HasMutationTx for details.
+func (g *Graph) HasMutationTx() bool {
+	for _, n := range g.Nodes {
+		if n.HasMutationTx() {
+			return true
+		}
+	}
+	return false
+}
+
 // MutableNodes returns the list of nodes that are mutable. i.e., not views.
 func (g *Graph) MutableNodes() []*Type {
 	nodes := make([]*Type, 0, len(g.Nodes))
@@ -220,6 +302,10 @@ func (g *Graph) defaults() {
 			idTypes = append(idTypes, n.ID.Type)
 		}
 	}
+	// All nodes use composite identifiers.
+	if len(idTypes) == 0 {
+		return
+	}
 	// Check that all nodes have the same type for the ID field.
 	for i := 0; i < len(idTypes)-1; i++ {
 		if idTypes[i].Type != idTypes[i+1].Type {
@@ -311,6 +397,11 @@ func (g *Graph) addIndexes(schema *load.Schema) {
 	for _, idx := range schema.Indexes {
 		check(typ.AddIndex(idx), "invalid index for schema %q", schema.Name)
 	}
+	// Polymorphic edges are looked up by the type and the ID of their neighbors.
+	for _, e := range typ.PolymorphicEdges {
+		idx := &load.Index{Fields: []string{e.TypeField().Name, e.IDField().Name}}
+		check(typ.AddIndex(idx), "invalid index for polymorphic edge %s.%s", schema.Name, e.Name)
+	}
 }
 
 // addEdges adds the node edges to the graph.
@@ -326,6 +417,9 @@ func (g *Graph) addEdges(schema *load.Schema) {
 		expect(!ok, "%s schema contains multiple %q edges", schema.Name, e.Name)
 		seen[e.Name] = struct{}{}
 		switch {
+		// Polymorphic.
+		case len(e.Types) > 0:
+			g.addPolymorphicEdge(t, e)
 		// Assoc only.
 		case !e.Inverse:
 			t.Edges = append(t.Edges, &Edge{
@@ -391,6 +485,41 @@ func (g *Graph) addEdges(schema *load.Schema) {
 	}
 }
 
+// addPolymorphicEdge adds to the type an edge that can point to more than
+// one type, and the fields that hold the type and the ID of its neighbor.
+func (g *Graph) addPolymorphicEdge(t *Type, e *load.Edge) {
+	expect(g.Storage == nil || g.Storage.Name == "sql", "polymorphic edge %s.%s is not supported by storage driver %q", t.Name, e.Name, g.Storage)
+	expect(e.Unique, "polymorphic edge %s.%s must be unique", t.Name, e.Name)
+	expect(e.Field == "" && e.Ref == nil && e.Through == nil, "polymorphic edge %s.%s cannot be bound to a field, a back-reference or an edge schema", t.Name, e.Name)
+	pe := &Edge{
+		def:         e,
+		Name:        e.Name,
+		Owner:       t,
+		Unique:      true,
+		Optional:    !e.Required,
+		Immutable:   e.Immutable,
+		StructTag:   structTag(e.Name, e.Tag),
+		Annotations: e.Annotations,
+		Rel:         Relation{Type: M2O, Table: t.Table()},
+	}
+	_, ok := g.typ(pe.InterfaceName())
+	expect(!ok, "polymorphic edge %s.%s conflicts with the %s type", t.Name, e.Name, pe.InterfaceName())
+	for _, name := range e.Types {
+		typ, ok := g.typ(name)
+		expect(ok, "type %q does not exist for edge", name)
+		expect(typ.HasOneFieldID(), "type %q of polymorphic edge %s.%s must have an id field", name, t.Name, e.Name)
+		expect(!typ.ID.IsBytes(), "type %q of polymorphic edge %s.%s cannot have a bytes id", name, t.Name, e.Name)
+		for _, et := range pe.Types {
+			expect(et != typ, "polymorphic edge %s.%s contains the %q type more than once", t.Name, e.Name, name)
+			expect(et.ID.Type.String() == typ.ID.Type.String(), "types of polymorphic edge %s.%s must have the same id type", t.Name, e.Name)
+		}
+		pe.Types = append(pe.Types, typ)
+	}
+	check(t.addPolymorphicFields(pe), "polymorphic edge %s.%s", t.Name, e.Name)
+	pe.Rel.Columns = []string{pe.IDField().StorageKey()}
+	t.PolymorphicEdges = append(t.PolymorphicEdges, pe)
+}
+
 // resolve the type references and relations of its edges.
 // It fails if one of the references is missing or invalid.
 //
@@ -490,6 +619,28 @@ func (g *Graph) resolve(t *Type) error {
 	return nil
 }
 
+// compositeIDs resolves the composite primary keys of regular types, that were defined using the
+// field.ID annotation. Composite identifiers of edge schemas are resolved by edgeSchemas, as they
+// must match the edge fields that go through them.
+func (g *Graph) compositeIDs() error {
+	through := make(map[string]bool)
+	for _, n := range g.Nodes {
+		for _, e := range n.Edges {
+			if e.def.Through != nil {
+				through[e.def.Through.T] = true
+			}
+		}
+	}
+	for _, n := range g.Nodes {
+		if ant := fieldAnnotate(n.Annotations); ant != nil && len(ant.ID) > 0 && !through[n.Name] {
+			if err := n.setCompositeID(ant.ID); err != nil {
+				return fmt.Errorf("type %s: %w", n.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
 // edgeSchemas visits all edges in the graph and detects which schemas are used as "edge schemas".
 // Note, edge schemas cannot be used by more than one association (edge.To), must define two required
 // edges (+ edge-fields) to the types that go through them, and allow adding additional fields with
@@ -606,6 +757,7 @@ func (g *Graph) edgeSchemas() error {
 				for _, f := range ant.ID {
 					edgeT.EdgeSchema.ID = append(edgeT.EdgeSchema.ID, edgeT.fields[f])
 				}
+				edgeT.CompositeID = edgeT.EdgeSchema.ID
 			}
 			if edgeT.HasCompositeID() {
 				continue
@@ -651,12 +803,18 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 			table.SetAnnotation(ant).SetSchema(ant.Schema)
 		}
 		for _, f := range n.Fields {
-			if a := f.EntSQL(); a != nil && a.Skip {
+			if a := f.EntSQL(); a != nil && a.Skip || f.IsComputed() {
 				continue
 			}
 			if !f.IsEdgeField() {
 				table.AddColumn(f.Column())
 			}
+			if f.HasBlindIndex() {
+				table.AddColumn(f.BlindIndexSchemaColumn())
+				if !f.Unique {
+					table.AddIndex(strings.ToLower(n.Name)+"_"+f.BlindIndexColumn(), false, []string{f.BlindIndexColumn()})
+				}
+			}
 		}
 		switch {
 		case tables[table.Name] == nil:
@@ -783,9 +941,69 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 	if err := ensureUniqueFKs(tables); err != nil {
 		return nil, err
 	}
+	for _, n := range g.Nodes {
+		if name := n.HistoryTable(); name != "" && tables[n.Table()] != nil {
+			all = append(all, historyTable(name, n, tables[n.Table()]))
+		}
+	}
+	if g.HasOutbox() {
+		all = append(all, outboxTable())
+	}
 	return
 }
 
+// outboxTable returns the table that holds the events written by the "sql/outbox" feature.
+func outboxTable() *schema.Table {
+	t := schema.NewTable("ent_outbox").
+		AddPrimary(&schema.Column{Name: "id", Type: field.TypeInt, Increment: true}).
+		AddColumn(&schema.Column{Name: "type", Type: field.TypeString}).
+		AddColumn(&schema.Column{Name: "op", Type: field.TypeString}).
+		AddColumn(&schema.Column{Name: "node_id", Type: field.TypeString}).
+		AddColumn(&schema.Column{Name: "payload", Type: field.TypeJSON, Nullable: true}).
+		AddColumn(&schema.Column{Name: "create_time", Type: field.TypeTime}).
+		AddColumn(&schema.Column{Name: "delivered_time", Type: field.TypeTime, Nullable: true})
+	t.AddIndex("ent_outbox_delivered_time", false, []string{"delivered_time"})
+	return t
+}
+
+// historyTable returns the table that records the changes of the given type.
+// The table holds the row image of the type along with the operation metadata.
+func historyTable(name string, n *Type, t *schema.Table) *schema.Table {
+	h := schema.NewTable(name).
+		SetSchema(t.Schema).
+		SetPos(n.Pos()).
+		AddPrimary(&schema.Column{Name: "history_id", Type: field.TypeInt, Increment: true}).
+		AddColumn(&schema.Column{Name: "history_time", Type: field.TypeTime}).
+		AddColumn(&schema.Column{Name: "history_operation", Type: field.TypeEnum, Enums: []string{"CREATE", "UPDATE", "DELETE"}}).
+		AddColumn(&schema.Column{Name: "history_actor", Type: field.TypeString, Nullable: true})
+	for _, f := range append([]*Field{n.ID}, n.Fields...) {
+		c, ok := t.Column(f.StorageKey())
+		if !ok {
+			continue
+		}
+		h.AddColumn(&schema.Column{
+			Name:       c.Name,
+			Type:       c.Type,
+			SchemaType: c.SchemaType,
+			Size:       c.Size,
+			Enums:      c.Enums,
+			Collation:  c.Collation,
+			Nullable:   f != n.ID,
+		})
+	}
+	// Foreign-keys that are not defined as fields are recorded as well,
+	// as they are needed for loading the edges of past states.
+	for _, fk := range n.UnexportedForeignKeys() {
+		c, ok := t.Column(fk.Edge.Rel.Column())
+		if !ok {
+			continue
+		}
+		h.AddColumn(&schema.Column{Name: c.Name, Type: c.Type, SchemaType: c.SchemaType, Size: c.Size, Nullable: true})
+	}
+	h.AddIndex(name+"_"+n.ID.StorageKey()+"_history_time", false, []string{n.ID.StorageKey(), "history_time"})
+	return h
+}
+
 // Views returns all schema views
 func (g *Graph) Views() (views []*schema.Table, err error) {
 	for _, n := range g.Nodes {
@@ -803,7 +1021,7 @@ func (g *Graph) Views() (views []*schema.Table, err error) {
 			view.SetAnnotation(ant).SetSchema(ant.Schema)
 		}
 		for _, f := range n.Fields {
-			if a := f.EntSQL(); a != nil && a.Skip {
+			if a := f.EntSQL(); a != nil && a.Skip || f.IsComputed() {
 				continue
 			}
 			view.AddColumn(f.Column())
@@ -836,18 +1054,13 @@ func fkColumn(e *Edge, owner *schema.Table, refPK *schema.Column) *schema.Column
 }
 
 func addCompositePK(t *schema.Table, n *Type) error {
-	columns := make([]*schema.Column, 0, len(n.EdgeSchema.ID))
-	for _, id := range n.EdgeSchema.ID {
-		for _, f := range n.Fields {
-			if !f.IsEdgeField() || id != f {
-				continue
-			}
-			c, ok := t.Column(f.StorageKey())
-			if !ok {
-				return fmt.Errorf("missing column %q for edge field %q.%q", f.StorageKey(), n.Name, f.Name)
-			}
-			columns = append(columns, c)
+	columns := make([]*schema.Column, 0, len(n.CompositeID))
+	for _, f := range n.CompositeID {
+		c, ok := t.Column(f.StorageKey())
+		if !ok {
+			return fmt.Errorf("missing column %q for composite identifier field %q.%q", f.StorageKey(), n.Name, f.Name)
 		}
+		columns = append(columns, c)
 	}
 	t.PrimaryKey = columns
 	return nil
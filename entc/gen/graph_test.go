@@ -6,6 +6,7 @@ package gen
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -17,6 +18,7 @@ import (
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
 
 	"github.com/stretchr/testify/require"
 )
@@ -514,6 +516,355 @@ func TestEnsureCorrectFK(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSoftDelete(t *testing.T) {
+	ant := dict(mixin.SoftDeleteAnnotation{}.Name(), mixin.SoftDeleteAnnotation{Field: "delete_time"})
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "delete_time", Info: &field.TypeInfo{Type: field.TypeTime}, Nillable: true, Optional: true},
+		},
+		Annotations: ant,
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.True(t, g.HasSoftDelete())
+	require.Equal(t, "delete_time", g.Nodes[0].SoftDeleteField().Name)
+
+	user.Fields[0].Optional = false
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: invalid soft delete for schema "User": soft delete field "delete_time" must be optional and mutable`)
+	user.Fields[0].Optional = true
+
+	user.Fields[0].Info = &field.TypeInfo{Type: field.TypeString}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: invalid soft delete for schema "User": soft delete field "delete_time" must be a time field`)
+
+	user.Fields = nil
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: invalid soft delete for schema "User": soft delete field "delete_time" was not found`)
+
+	user.Annotations = nil
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.False(t, g.HasSoftDelete())
+	require.Nil(t, g.Nodes[0].SoftDeleteField())
+}
+
+func TestPolymorphicEdge(t *testing.T) {
+	comment := &load.Schema{
+		Name: "Comment",
+		Edges: []*load.Edge{
+			{Name: "target", Type: "Post", Types: []string{"Post", "Photo"}, Unique: true},
+		},
+	}
+	post, photo := &load.Schema{Name: "Post"}, &load.Schema{Name: "Photo"}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, comment, post, photo)
+	require.NoError(t, err)
+	c := g.Nodes[0]
+	require.Empty(t, c.Edges)
+	require.Len(t, c.PolymorphicEdges, 1)
+	e := c.PolymorphicEdges[0]
+	require.Equal(t, []*Type{g.Nodes[1], g.Nodes[2]}, e.Types)
+	require.Equal(t, "CommentTarget", e.InterfaceName())
+	require.Equal(t, M2O, e.Rel.Type)
+	require.Equal(t, []string{"target_id"}, e.Rel.Columns)
+	require.Equal(t, "target_type", e.TypeField().Name)
+	require.True(t, e.TypeField().IsEnum())
+	require.Equal(t, []string{"Post", "Photo"}, e.TypeField().EnumValues())
+	require.True(t, e.TypeField().Optional && e.TypeField().Nillable)
+	require.Equal(t, "target_id", e.IDField().Name)
+	require.Equal(t, field.TypeInt, e.IDField().Type.Type)
+	require.True(t, e.IDField().IsPolymorphicField())
+	require.False(t, e.IDField().SupportsMutationAdd(), "numeric operations are not generated for polymorphic fields")
+	require.Len(t, c.Indexes, 1)
+	require.Equal(t, []string{"target_type", "target_id"}, c.Indexes[0].Columns)
+
+	comment.Edges[0].Unique = false
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, comment, post, photo)
+	require.EqualError(t, err, "entc/gen: polymorphic edge Comment.target must be unique")
+	comment.Edges[0].Unique = true
+
+	comment.Edges[0].Types = []string{"Post", "Video"}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, comment, post, photo)
+	require.EqualError(t, err, `entc/gen: type "Video" does not exist for edge`)
+	comment.Edges[0].Types = []string{"Post", "Photo"}
+
+	photo.Fields = []*load.Field{{Name: "id", Info: &field.TypeInfo{Type: field.TypeString}}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, comment, post, photo)
+	require.EqualError(t, err, "entc/gen: types of polymorphic edge Comment.target must have the same id type")
+	photo.Fields = nil
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[1]}, comment, post, photo)
+	require.EqualError(t, err, `entc/gen: polymorphic edge Comment.target is not supported by storage driver "gremlin"`)
+}
+
+func TestHistory(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Default: true, DefaultValue: 1},
+		},
+		Annotations: dict(entsql.Annotation{}.Name(), entsql.History()),
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.False(t, g.HasHistory(), "feature is disabled")
+	require.Empty(t, g.Nodes[0].HistoryTable())
+
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureHistory}}, user)
+	require.NoError(t, err)
+	require.True(t, g.HasHistory())
+	require.Equal(t, "users_history", g.Nodes[0].HistoryTable())
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	h := tables[1]
+	require.Equal(t, "users_history", h.Name)
+	require.Len(t, h.PrimaryKey, 1)
+	require.Equal(t, "history_id", h.PrimaryKey[0].Name)
+	var columns []string
+	for _, c := range h.Columns {
+		columns = append(columns, c.Name)
+		require.False(t, c.Unique)
+		require.Nil(t, c.Default)
+	}
+	require.Equal(t, []string{"history_id", "history_time", "history_operation", "history_actor", "id", "name", "age"}, columns)
+	require.False(t, h.Columns[4].Nullable)
+	require.True(t, h.Columns[5].Nullable)
+	require.Len(t, h.Indexes, 1)
+
+	// Foreign-keys that are not defined as fields are recorded as well.
+	user.Edges = []*load.Edge{{Name: "children", Type: "User", RefName: "parent", Unique: true}}
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureHistory}}, user)
+	require.NoError(t, err)
+	tables, err = g.Tables()
+	require.NoError(t, err)
+	h = tables[1]
+	require.Len(t, h.Columns, 8)
+	require.Equal(t, "user_children", h.Columns[7].Name)
+	require.True(t, h.Columns[7].Nullable)
+	require.Empty(t, h.ForeignKeys)
+	user.Edges = nil
+
+	user.Fields = append(user.Fields, &load.Field{Name: "history_time", Info: &field.TypeInfo{Type: field.TypeTime}})
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureHistory}}, user)
+	require.EqualError(t, err, `entc/gen: invalid history for schema "User": field "history_time" conflicts with the history table columns`)
+}
+
+func TestOutbox(t *testing.T) {
+	user := &load.Schema{
+		Name:        "User",
+		Annotations: dict(entsql.Annotation{}.Name(), entsql.Outbox()),
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.False(t, g.HasOutbox(), "feature is disabled")
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureOutbox}}, user)
+	require.NoError(t, err)
+	require.True(t, g.HasOutbox())
+	require.True(t, g.Nodes[0].HasOutbox())
+	tables, err = g.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	require.Equal(t, "ent_outbox", tables[1].Name)
+	require.Len(t, tables[1].Columns, 7)
+	require.Len(t, tables[1].Indexes, 1)
+}
+
+func TestTenancy(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Annotations: dict(entsql.Annotation{}.Name(), entsql.Tenant("tenant_id")),
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.Nil(t, g.TenantType(), "feature is disabled")
+	require.Nil(t, g.Nodes[0].TenantField())
+
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureTenancy}}, user)
+	require.NoError(t, err)
+	require.Equal(t, "int", g.TenantType().String())
+	require.Equal(t, "tenant_id", g.Nodes[0].TenantField().Name)
+
+	pet := &load.Schema{
+		Name: "Pet",
+		Fields: []*load.Field{
+			{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Annotations: dict(entsql.Annotation{}.Name(), entsql.Tenant("tenant_id")),
+	}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureTenancy}}, user, pet)
+	require.EqualError(t, err, `entc/gen: invalid tenancy: tenant field "tenant_id" of schema "Pet" has type string, but int was used by other schemas`)
+
+	pet.Annotations = dict(entsql.Annotation{}.Name(), entsql.Tenant("owner"))
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureTenancy}}, user, pet)
+	require.EqualError(t, err, `entc/gen: invalid tenancy for schema "Pet": tenant field "owner" was not found`)
+}
+
+func TestVersion(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt}, Immutable: true, Version: true},
+		},
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.True(t, g.HasVersion())
+	require.Equal(t, "version", g.Nodes[0].VersionField().Name)
+
+	user.Fields = append(user.Fields, &load.Field{Name: "revision", Info: &field.TypeInfo{Type: field.TypeInt}, Version: true})
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: invalid version field for schema "User": multiple version fields: "version" and "revision"`)
+
+	user.Fields = []*load.Field{
+		{Name: "version", Info: &field.TypeInfo{Type: field.TypeString}, Version: true},
+	}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: invalid version field for schema "User": version field "version" must be an integer`)
+
+	user.Fields = []*load.Field{
+		{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true, Version: true},
+	}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: invalid version field for schema "User": version field "version" must not be optional or nillable`)
+}
+
+func TestEncrypted(t *testing.T) {
+	size := int64(11)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "ssn", Info: &field.TypeInfo{Type: field.TypeString}, Size: &size, ValueScanner: true, Encrypted: &field.Encryption{BlindIndex: true}},
+			{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, ValueScanner: true, Unique: true, Encrypted: &field.Encryption{BlindIndex: true}},
+			{Name: "secret", Info: &field.TypeInfo{Type: field.TypeBytes}, ValueScanner: true, Encrypted: &field.Encryption{}},
+		},
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	ssn, email, secret := g.Nodes[0].Fields[0], g.Nodes[0].Fields[1], g.Nodes[0].Fields[2]
+	require.True(t, ssn.HasBlindIndex())
+	require.Equal(t, "SsnBlindIndexColumn", ssn.BlindIndexConstant())
+	require.Equal(t, enumOps, ssn.Ops())
+	require.True(t, secret.IsEncrypted())
+	require.False(t, secret.HasBlindIndex())
+	require.Empty(t, secret.Ops())
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	c, ok := tables[0].Column("ssn_bidx")
+	require.True(t, ok)
+	require.Equal(t, field.TypeString, c.Type)
+	require.True(t, c.Nullable)
+	idx, ok := tables[0].Index("user_ssn_bidx")
+	require.True(t, ok)
+	require.False(t, idx.Unique)
+	c, _ = tables[0].Column(email.StorageKey())
+	require.False(t, c.Unique)
+	c, _ = tables[0].Column("email_bidx")
+	require.True(t, c.Unique)
+	require.False(t, tables[0].HasColumn("secret_bidx"))
+	// Encrypted columns are sized for the ciphertext, and not for the plaintext.
+	for _, f := range []*Field{ssn, email, secret} {
+		c, _ = tables[0].Column(f.StorageKey())
+		require.Equal(t, int64(math.MaxInt32), c.Size, f.Name)
+	}
+
+	user.Fields = []*load.Field{
+		{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, ValueScanner: true, Unique: true, Encrypted: &field.Encryption{}},
+	}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.EqualError(t, err, `entc/gen: create type User: unique encrypted field "email" must have a blind index`)
+	user.Fields[0].Encrypted.BlindIndex = true
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[1]}, user)
+	require.EqualError(t, err, `entc/gen: create type User: encrypted field "email" is not supported by storage driver "gremlin"`)
+}
+
+func TestCompositeID(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "accounts", Type: "Account"},
+		},
+	}
+	account := &load.Schema{
+		Name: "Account",
+		Fields: []*load.Field{
+			{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
+		},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "accounts", Unique: true, Inverse: true, Field: "owner_id"},
+		},
+		Annotations: dict("Fields", map[string]any{"ID": []string{"tenant_id", "id"}}),
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, account)
+	require.NoError(t, err)
+	n := g.Nodes[1]
+	require.True(t, n.HasCompositeID())
+	require.True(t, n.HasCompositeKey())
+	require.False(t, n.HasOneFieldID())
+	require.Nil(t, n.ID)
+	require.Len(t, n.Fields, 3)
+	require.Equal(t, n.Fields[:2], n.CompositeID)
+	require.True(t, n.Fields[0].Immutable)
+	require.True(t, n.Fields[1].Immutable)
+	require.Len(t, n.MutableFields(), 1)
+	require.Empty(t, g.Nodes[0].EdgesWithID())
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables[1].PrimaryKey, 2)
+	require.Equal(t, "tenant_id", tables[1].PrimaryKey[0].Name)
+	require.Equal(t, "id", tables[1].PrimaryKey[1].Name)
+	require.False(t, tables[1].PrimaryKey[1].Increment)
+
+	// Foreign keys cannot reference composite identifiers.
+	user.Edges = nil
+	account.Edges = []*load.Edge{{Name: "users", Type: "User"}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, account)
+	require.EqualError(t, err, `entc/gen: set "Account" foreign-keys: edge "users": foreign keys that reference composite identifiers are not supported. The foreign key must reside in the table of the type with the composite identifier`)
+	user.Edges = []*load.Edge{{Name: "accounts", Type: "Account", RefName: "users", Inverse: true}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, account)
+	require.EqualError(t, err, `entc/gen: set "User" foreign-keys: edge "accounts": M2M edges are not supported by types with composite identifiers`)
+
+	account.Edges = nil
+	user.Edges = nil
+	account.Fields[0].Optional = true
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, account)
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: type Account: composite identifier field "tenant_id" cannot be optional or nillable`)
+	account.Fields[0].Optional = false
+	account.Annotations = dict("Fields", map[string]any{"ID": []string{"tenant_id", "name"}})
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, account)
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: type Account: composite identifier field "name" was not found`)
+	account.Fields = account.Fields[:1]
+	account.Annotations = dict("Fields", map[string]any{"ID": []string{"tenant_id", "id"}})
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, account)
+	require.EqualError(t, err, `entc/gen: resolving composite identifiers: type Account: composite identifier field "id" must be defined in the schema`)
+}
+
+func TestCompositeID_AllNodes(t *testing.T) {
+	account := &load.Schema{
+		Name: "Account",
+		Fields: []*load.Field{
+			{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Annotations: dict("Fields", map[string]any{"ID": []string{"tenant_id", "id"}}),
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, account)
+	require.NoError(t, err)
+	require.True(t, g.Nodes[0].HasCompositeID())
+	require.Equal(t, defaultIDType, g.IDType)
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(t.TempDir(), "ent")
@@ -525,14 +876,25 @@ func TestGraph_Gen(t *testing.T) {
 			Fields: []*load.Field{
 				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
 				{Name: "expired_at", Info: &field.TypeInfo{Type: field.TypeTime}, Nillable: true, Optional: true},
-				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+				{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt}, Immutable: true, Version: true},
 			},
 			Edges: []*load.Edge{
 				{Name: "t1", Type: "T1", Unique: true},
 			},
 		},
 		{Name: "T2"},
-		{Name: "T3"},
+		{
+			Name: "T3",
+			Fields: []*load.Field{
+				{Name: "delete_time", Info: &field.TypeInfo{Type: field.TypeTime}, Nillable: true, Optional: true},
+				{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeString}},
+			},
+			Annotations: dict(
+				mixin.SoftDeleteAnnotation{}.Name(), mixin.SoftDeleteAnnotation{Field: "delete_time"},
+				entsql.Annotation{}.Name(), &entsql.Annotation{History: true, Outbox: true, Tenant: "tenant"},
+			),
+		},
 	}
 	graph, err := NewGraph(&Config{
 		Package:   "entc/gen",
@@ -567,15 +929,61 @@ func TestGraph_Gen(t *testing.T) {
 	require.NoError(err)
 	_, err = os.Stat(filepath.Join(target, "skipped.go"))
 	require.True(os.IsNotExist(err))
+	c, err := os.ReadFile(filepath.Join(target, "t1_update.go"))
+	require.NoError(err)
+	require.Contains(string(c), "_spec.SetVersion(t1.FieldVersion, field.TypeInt, version)")
+	require.Contains(string(c), "err = &StaleObjectError{label: t1.Label, wrap: err}")
+	require.Contains(string(c), "func (_u *T1UpdateOne) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error)")
+	require.Contains(string(c), "sqlgraph.BatchUpdate(ctx, _u.driver, _spec)")
+	require.Contains(string(c), "func (_u *T1Update) SaveReturning(ctx context.Context) ([]*T1, error)")
+	c, err = os.ReadFile(filepath.Join(target, "t1_query.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (_q *T1Query) Recursive(maxDepth int) *T1Query")
+	require.Contains(string(c), "fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)")
+	c, err = os.ReadFile(filepath.Join(target, "t1_delete.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (_d *T1Delete) ExecReturning(ctx context.Context) ([]*T1, error)")
+	c, err = os.ReadFile(filepath.Join(target, "t1_create.go"))
+	require.NoError(err)
+	require.Contains(string(c), "spec := &sqlgraph.BatchCreateSpec{Nodes: specs, BatchSize: _c.batchSize}")
+	c, err = os.ReadFile(filepath.Join(target, "client.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error, opts ...TxOption) error")
+	require.Contains(string(c), "func (c *T1Client) UpdateBulk(builders ...*T1UpdateOne) *T1UpdateBulk")
+	require.Contains(string(c), "func BatchSize(n int) Option")
+	require.Contains(string(c), "func (c *T1Client) Path(ctx context.Context, from, to int, edges ...string) ([]int, error)")
+	require.Contains(string(c), "func (c *T1Client) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error)")
+	require.NotContains(string(c), "func (c *T2Client) Path(")
+	c, err = os.ReadFile(filepath.Join(target, "ent.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func newConstraintError(err error) *ConstraintError")
+	require.Contains(string(c), `{table: "t1s", columns: []string{"name"}}`)
+	c, err = os.ReadFile(filepath.Join(target, "tx.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (tx *Tx) Savepoint(ctx context.Context, name string) (*Tx, error)")
 
 	// Generated feature templates.
 	_, err = os.Stat(filepath.Join(target, "internal", "schema.go"))
 	require.NoError(err)
 	_, err = os.Stat(filepath.Join(target, "internal", "schemaconfig.go"))
 	require.NoError(err)
-	c, err := os.ReadFile(filepath.Join(target, "internal", "globalid.go"))
+	c, err = os.ReadFile(filepath.Join(target, "internal", "globalid.go"))
 	require.NoError(err)
 	require.Contains(string(c), fmt.Sprintf(`"{\"t1s\":0,\"t2s\":%d,\"t3s\":%d}"`, 1<<32, 2<<32))
+	c, err = os.ReadFile(filepath.Join(target, "history.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (c *T3Client) History(id int) *T3HistoryQuery")
+	c, err = os.ReadFile(filepath.Join(target, "outbox.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (c *T3Client) outboxHook() Hook")
+	c, err = os.ReadFile(filepath.Join(target, "iter.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (_q *T1Query) IterBatches(ctx context.Context, size int) iter.Seq2[[]*T1, error]")
+	require.Contains(string(c), "func (_q *T1Query) iterIDs(ctx context.Context) ([]int, error)", "nodes are loaded in batches by their identifiers")
+	c, err = os.ReadFile(filepath.Join(target, "tenancy.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func WithTenant(ctx context.Context, tenant string) context.Context")
+	require.Contains(string(c), "func (c *T3Client) tenantHook() Hook")
 	// Rerun codegen with only one feature-flag.
 	graph.Features = []Feature{FeatureSnapshot}
 	require.NoError(graph.Gen())
@@ -586,6 +994,18 @@ func TestGraph_Gen(t *testing.T) {
 	require.True(os.IsNotExist(err))
 	_, err = os.Stat(filepath.Join(target, "internal", "globalid.go"))
 	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "history.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "outbox.go"))
+	require.True(os.IsNotExist(err))
+	// Keyset pagination is generated regardless of the feature-flags.
+	c, err = os.ReadFile(filepath.Join(target, "paginate.go"))
+	require.NoError(err)
+	require.Contains(string(c), "func (_q *T1Query) Page(ctx context.Context, after *PageCursor, first int, orderBy ...*sql.OrderFieldTerm) (*T1Page, error)")
+	_, err = os.Stat(filepath.Join(target, "iter.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "tenancy.go"))
+	require.True(os.IsNotExist(err))
 	// Rerun codegen without any feature-flags.
 	graph.Features = nil
 	require.NoError(graph.Gen())
@@ -58,6 +58,7 @@ var drivers = []*Storage{
 		Imports: []string{
 			"database/sql/driver",
 			"entgo.io/ent/dialect/sql",
+			"entgo.io/ent/dialect/sql/sqlarray",
 			"entgo.io/ent/dialect/sql/sqlgraph",
 			"entgo.io/ent/dialect/sql/sqljson",
 			"entgo.io/ent/schema/field",
@@ -143,6 +143,11 @@ var (
 			Name:   "hook",
 			Format: "hook/hook.go",
 		},
+		{
+			Name:   "dialect/sql/paginate",
+			Format: "paginate.go",
+			Skip:   func(g *Graph) bool { return g.Storage.Name != "sql" },
+		},
 		{
 			Name:   "privacy",
 			Format: "privacy/privacy.go",
@@ -164,6 +169,34 @@ var (
 				return !g.featureEnabled(FeatureEntQL)
 			},
 		},
+		{
+			Name:   "dialect/sql/history",
+			Format: "history.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureHistory) || g.Storage.Name != "sql"
+			},
+		},
+		{
+			Name:   "dialect/sql/outbox",
+			Format: "outbox.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureOutbox) || g.Storage.Name != "sql"
+			},
+		},
+		{
+			Name:   "dialect/sql/iter",
+			Format: "iter.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureIter) || g.Storage.Name != "sql"
+			},
+		},
+		{
+			Name:   "dialect/sql/tenancy",
+			Format: "tenancy.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureTenancy) || g.Storage.Name != "sql" || g.TenantType() == nil
+			},
+		},
 		{
 			Name:   "runtime/ent",
 			Format: "runtime.go",
@@ -11,8 +11,10 @@ import (
 	"fmt"
 	"go/token"
 	"go/types"
+	"math"
 	"path"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"unicode"
@@ -26,6 +28,7 @@ import (
 	entschema "entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
 )
 
 // The following types and their exported methods used by the codegen
@@ -43,11 +46,21 @@ type (
 		alias string
 		// ID holds the ID field of this type.
 		ID *Field
+		// CompositeID holds the fields of the composite primary key of this type, if it
+		// was defined using the field.ID annotation. Types with composite identifiers
+		// (regular types, or edge schemas) do not have an ID field.
+		CompositeID []*Field
 		// Fields holds all the primitive fields of this type.
 		Fields []*Field
 		fields map[string]*Field
+		// Embedded holds the struct fields of this type that are flattened into
+		// multiple fields, one for each member. The member fields are part of Fields.
+		Embedded []*Embedded
 		// Edge holds all the edges of this type.
 		Edges []*Edge
+		// PolymorphicEdges holds the edges of this type that can point to more
+		// than one type. Unlike other edges, they are not part of the Edges list.
+		PolymorphicEdges []*Edge
 		// Indexes are the configured indexes for this type.
 		Indexes []*Index
 		// ForeignKeys are the foreign-keys that resides in the type table.
@@ -102,6 +115,8 @@ type (
 		Annotations Annotations
 		// referenced foreign-key.
 		fk *ForeignKey
+		// polymorphic edge that is backed by the field.
+		poly *Edge
 	}
 
 	// Edge of a graph between two types.
@@ -143,6 +158,38 @@ type (
 		// Annotations that were defined for the edge in the schema.
 		// The mapping is from the Annotation.Name() to a JSON decoded object.
 		Annotations Annotations
+		// Types holds the types this edge can point to, in case it is a polymorphic edge.
+		// For example:
+		//
+		//	edge.To("target", Post.Type, Photo.Type).Unique()
+		//
+		// The Type of polymorphic edges is nil, and their neighbor is stored in two fields
+		// of the owner type. One holds the type name of the neighbor, and one holds its ID.
+		Types []*Type
+	}
+
+	// Embedded holds the information of a struct field that is flattened
+	// into multiple fields (and columns), one for each member of the struct.
+	Embedded struct {
+		def *load.Field
+		// Name is the name of the field in the schema (e.g. address).
+		Name string
+		// Type holds the type information of the Go struct.
+		Type *field.TypeInfo
+		// Optional indicates that the struct and all its members are optional.
+		Optional bool
+		// Immutable indicates that the struct and all its members cannot be updated.
+		Immutable bool
+		// StructTag of the struct field in the generated entity.
+		StructTag string
+		// Validators holds the number of validators of the struct.
+		Validators int
+		// Position info of the field in the schema.
+		Position *load.Position
+		// Fields holds the fields of the struct members (e.g. address_city).
+		Fields []*Field
+		// Members holds the names of the struct members, by the order of the Fields.
+		Members []string
 	}
 
 	// Relation holds the relational database information for edges.
@@ -237,6 +284,12 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 		return nil, err
 	}
 	for _, f := range schema.Fields {
+		if len(f.Embedded) > 0 {
+			if err := typ.addEmbedded(f); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		tf := &Field{
 			cfg:           c,
 			def:           f,
@@ -249,7 +302,7 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			Optional:      f.Optional,
 			Default:       f.Default,
 			UpdateDefault: f.UpdateDefault,
-			Immutable:     f.Immutable,
+			Immutable:     f.Immutable || f.Generated != nil || f.Computed,
 			StructTag:     structTag(f.Name, f.Tag),
 			Validators:    f.Validators,
 			UserDefined:   true,
@@ -263,15 +316,71 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			if tf.Optional {
 				return nil, errors.New("id field cannot be optional")
 			}
+			if tf.IsGenerated() {
+				return nil, errors.New("id field cannot be generated")
+			}
+			if tf.IsComputed() {
+				return nil, errors.New("id field cannot be computed")
+			}
+			if tf.IsEncrypted() {
+				return nil, errors.New("id field cannot be encrypted")
+			}
 			typ.ID = tf
 		} else {
 			typ.Fields = append(typ.Fields, tf)
 			typ.fields[f.Name] = tf
 		}
 	}
+	for _, em := range typ.Embedded {
+		if _, ok := typ.fields[em.Name]; ok {
+			return nil, fmt.Errorf("field %q redeclared for type %q", em.Name, typ.Name)
+		}
+	}
 	return typ, nil
 }
 
+// addEmbedded adds to the type an embedded struct field, and the
+// fields it is flattened into, one for each member of the struct.
+func (t *Type) addEmbedded(f *load.Field) error {
+	em := &Embedded{
+		def:        f,
+		Name:       f.Name,
+		Type:       f.Info,
+		Optional:   f.Optional,
+		Immutable:  f.Immutable,
+		StructTag:  structTag(f.Name, f.Tag),
+		Validators: f.Validators,
+		Position:   f.Position,
+	}
+	for _, m := range f.Embedded {
+		mf := *m
+		mf.Name = fmt.Sprintf("%s_%s", f.Name, snake(m.Name))
+		mf.Optional, mf.Nillable, mf.Immutable = f.Optional, f.Optional, f.Immutable
+		mf.Comment = fmt.Sprintf("The %s member of the %q field.", m.Name, f.Name)
+		tf := &Field{
+			cfg:         t.Config,
+			def:         &mf,
+			typ:         t,
+			Name:        mf.Name,
+			Type:        mf.Info,
+			Nillable:    mf.Nillable,
+			Optional:    mf.Optional,
+			Immutable:   mf.Immutable,
+			StructTag:   `json:"-"`,
+			UserDefined: true,
+		}
+		if err := t.checkField(tf, &mf); err != nil {
+			return err
+		}
+		t.Fields = append(t.Fields, tf)
+		t.fields[mf.Name] = tf
+		em.Fields = append(em.Fields, tf)
+		em.Members = append(em.Members, m.Name)
+	}
+	t.Embedded = append(t.Embedded, em)
+	return nil
+}
+
 // IsView indicates if the type (schema) is a view.
 func (t Type) IsView() bool {
 	return t.schema != nil && t.schema.View
@@ -285,7 +394,66 @@ func (t Type) IsEdgeSchema() bool {
 
 // HasCompositeID indicates if the type has a composite ID field.
 func (t Type) HasCompositeID() bool {
-	return t.IsEdgeSchema() && len(t.EdgeSchema.ID) > 1
+	return len(t.CompositeID) > 1
+}
+
+// setCompositeID sets the composite primary key of a regular (non edge-schema) type.
+// The ID field of the type is removed, and the "id" field becomes a regular field
+// in case it is part of the composite identifier.
+func (t *Type) setCompositeID(names []string) error {
+	switch {
+	case t.IsView():
+		return errors.New("views cannot have composite identifiers")
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("composite identifiers are not supported by storage driver %q", t.Storage.Name)
+	case t.fields["key"] != nil:
+		return errors.New(`field "key" conflicts with the generated Key type of composite identifiers`)
+	}
+	fields := make([]*Field, 0, len(names))
+	for _, name := range names {
+		f, ok := t.fields[name]
+		if !ok && t.ID != nil && name == t.ID.Name {
+			if !t.ID.UserDefined {
+				return fmt.Errorf("composite identifier field %q must be defined in the schema", name)
+			}
+			f = t.ID
+			// Keep the field in the position it was defined in the schema.
+			i := 0
+			for _, sf := range t.schema.Fields {
+				if sf.Name == name {
+					break
+				}
+				if _, ok := t.fields[sf.Name]; ok {
+					i++
+				}
+			}
+			t.Fields = slices.Insert(t.Fields, i, f)
+			t.fields[name] = f
+		}
+		switch {
+		case f == nil:
+			return fmt.Errorf("composite identifier field %q was not found", name)
+		case slices.Contains(fields, f):
+			return fmt.Errorf("composite identifier field %q was defined more than once", name)
+		case f.Optional || f.Nillable:
+			return fmt.Errorf("composite identifier field %q cannot be optional or nillable", name)
+		case f.IsJSON(), f.IsComputed(), f.IsGenerated(), f.IsEncrypted():
+			return fmt.Errorf("composite identifier field %q must be a comparable field that is stored in the table", name)
+		}
+		// Primary key values cannot be changed by update operations.
+		f.Immutable = true
+		fields = append(fields, f)
+	}
+	t.ID = nil
+	t.CompositeID = fields
+	return nil
+}
+
+// HasCompositeKey indicates if the type is a regular type (not an edge schema) with a composite
+// identifier. These types have a generated Key type that is used for getting, updating and
+// deleting entities by their primary key.
+func (t Type) HasCompositeKey() bool {
+	return t.HasCompositeID() && !t.IsEdgeSchema()
 }
 
 // HasOneFieldID indicates if the type has an ID with one field (not composite).
@@ -293,6 +461,92 @@ func (t Type) HasOneFieldID() bool {
 	return !t.HasCompositeID() && t.ID != nil
 }
 
+// addPolymorphicFields adds to the type the fields that hold the type
+// and the identifier of the neighbor of the given polymorphic edge.
+func (t *Type) addPolymorphicFields(e *Edge) error {
+	enums := make([]struct{ N, V string }, len(e.Types))
+	for i, et := range e.Types {
+		enums[i].N, enums[i].V = et.Name, et.Name
+	}
+	idType := *e.Types[0].ID.Type
+	for _, f := range []*load.Field{
+		{
+			Name:      polymorphicField(e.Name, "type"),
+			Info:      &field.TypeInfo{Type: field.TypeEnum},
+			Enums:     enums,
+			Optional:  e.Optional,
+			Nillable:  e.Optional,
+			Immutable: e.Immutable,
+			Comment:   fmt.Sprintf("The type of the neighbor of the %q edge.", e.Name),
+		},
+		{
+			Name:      polymorphicField(e.Name, "id"),
+			Info:      &idType,
+			Optional:  e.Optional,
+			Nillable:  e.Optional,
+			Immutable: e.Immutable,
+			Comment:   fmt.Sprintf("The ID of the neighbor of the %q edge.", e.Name),
+		},
+	} {
+		tf := &Field{
+			cfg:         t.Config,
+			def:         f,
+			typ:         t,
+			Name:        f.Name,
+			Type:        f.Info,
+			Nillable:    f.Nillable,
+			Optional:    f.Optional,
+			Immutable:   f.Immutable,
+			StructTag:   structTag(f.Name, ""),
+			UserDefined: true,
+			poly:        e,
+		}
+		if err := t.checkField(tf, f); err != nil {
+			return err
+		}
+		t.Fields = append(t.Fields, tf)
+		t.fields[f.Name] = tf
+	}
+	return nil
+}
+
+// polymorphicField returns the name of a field that is generated for a polymorphic edge.
+func polymorphicField(edge, suffix string) string {
+	return fmt.Sprintf("%s_%s", snake(edge), suffix)
+}
+
+// SelfEdges returns the edges that connect the type to itself (e.g. friends, or parent and children).
+func (t Type) SelfEdges() []*Edge {
+	var edges []*Edge
+	for _, e := range t.Edges {
+		if e.Type.Name == t.Name {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// RecursiveEdges returns the self-referencing edges of the type (e.g. parent and children)
+// that can be traversed recursively by the queries of SQL storage.
+func (t Type) RecursiveEdges() []*Edge {
+	if !t.HasOneFieldID() || t.IsView() || t.Storage == nil || t.Storage.Name != "sql" {
+		return nil
+	}
+	return t.SelfEdges()
+}
+
+// HasPathEdges indicates if the client of the type can query paths between
+// its nodes. That is, the type has self-referencing edges and its ID can be
+// passed as-is to the database.
+func (t Type) HasPathEdges() bool {
+	return t.HasOneFieldID() && !t.IsView() && !t.ID.HasValueScanner() && !t.ID.IsBytes() && len(t.SelfEdges()) > 0
+}
+
+// HasRecursiveEdges indicates if the type has edges that can be traversed recursively.
+func (t Type) HasRecursiveEdges() bool {
+	return len(t.RecursiveEdges()) > 0
+}
+
 // Label returns Gremlin label name of the node/type.
 func (t Type) Label() string {
 	return snake(t.Name)
@@ -365,6 +619,11 @@ func (t Type) HasAssoc(name string) (*Edge, bool) {
 
 // HasValidators reports if any of the type's field has validators.
 func (t Type) HasValidators() bool {
+	for _, em := range t.Embedded {
+		if em.Validators > 0 {
+			return true
+		}
+	}
 	fields := t.Fields
 	if t.HasOneFieldID() && t.ID.UserDefined {
 		fields = append(fields, t.ID)
@@ -428,6 +687,11 @@ func (t Type) HasUpdateCheckers() bool {
 			return true
 		}
 	}
+	for _, em := range t.Embedded {
+		if em.Validators > 0 && !em.Immutable {
+			return true
+		}
+	}
 	for _, e := range t.Edges {
 		if e.Unique && !e.Optional {
 			return true
@@ -471,10 +735,15 @@ func (t Type) MixedInFields() []int {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
-		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0) {
+		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0 || f.IsComputed()) {
 			idx[f.Position.MixinIndex] = struct{}{}
 		}
 	}
+	for _, em := range t.Embedded {
+		if em.Position != nil && em.Position.MixedIn && em.Validators > 0 {
+			idx[em.Position.MixinIndex] = struct{}{}
+		}
+	}
 	return sortedKeys(idx)
 }
 
@@ -566,7 +835,77 @@ func (t Type) MutableFields() []*Field {
 func (t Type) ImmutableFields() []*Field {
 	fields := make([]*Field, 0, len(t.Fields))
 	for _, f := range t.Fields {
-		if f.Immutable {
+		if f.Immutable && !f.IsGenerated() && !f.IsComputed() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// WritableFields returns all type fields that can be set by the mutations.
+// i.e. all fields except the ones that are generated by the database, or
+// computed by the queries.
+func (t Type) WritableFields() []*Field {
+	fields := make([]*Field, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		if !f.IsGenerated() && !f.IsComputed() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GeneratedFields returns all type fields that are generated by the database.
+func (t Type) GeneratedFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.IsGenerated() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// ComputedFields returns all type fields that are computed by SQL expressions on query.
+func (t Type) ComputedFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.IsComputed() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// EncryptedFields returns all type fields that are encrypted on write and decrypted on scan.
+func (t Type) EncryptedFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.IsEncrypted() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// CursorFields returns all fields that can be encoded in pagination cursors. That is,
+// orderable table columns that are not sensitive, encrypted or computed.
+func (t Type) CursorFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Type.Comparable() && !f.Sensitive() && !f.IsEncrypted() && !f.IsComputed() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// SensitiveFields returns all writable fields that are marked as sensitive. Their
+// values are redacted from the mutation String and from the driver debug logs.
+func (t Type) SensitiveFields() []*Field {
+	var fields []*Field
+	for _, f := range t.WritableFields() {
+		if f.Sensitive() {
 			fields = append(fields, f)
 		}
 	}
@@ -577,7 +916,7 @@ func (t Type) ImmutableFields() []*Field {
 func (t Type) MutationFields() []*Field {
 	fields := make([]*Field, 0, len(t.Fields))
 	for _, f := range t.Fields {
-		if !f.IsEdgeField() {
+		if !f.IsEdgeField() && !f.IsGenerated() && !f.IsComputed() {
 			fields = append(fields, f)
 		}
 	}
@@ -608,6 +947,103 @@ func (t Type) FieldBy(fn func(*Field) bool) (*Field, bool) {
 	return nil, false
 }
 
+// SoftDeleteField returns the time field that marks entities of this type
+// as deleted, or nil if the type was not annotated as soft-deletable.
+func (t Type) SoftDeleteField() *Field {
+	ant := softDeleteAnnotate(t.Annotations)
+	if ant == nil {
+		return nil
+	}
+	return t.fields[ant.Field]
+}
+
+// HistoryTable returns the name of the table that records the changes of
+// this type, or an empty string if the type is not tracked by the "sql/history"
+// feature.
+func (t Type) HistoryTable() string {
+	if t.Config == nil || !t.featureEnabled(FeatureHistory) || t.IsView() {
+		return ""
+	}
+	if ant := t.EntSQL(); ant == nil || !ant.History {
+		return ""
+	}
+	return t.Table() + "_history"
+}
+
+// HasOutbox reports if the mutations of this type are written as events
+// to the outbox table by the "sql/outbox" feature.
+func (t Type) HasOutbox() bool {
+	if t.Config == nil || !t.featureEnabled(FeatureOutbox) || t.IsView() {
+		return false
+	}
+	ant := t.EntSQL()
+	return ant != nil && ant.Outbox
+}
+
+// HasMutationTx reports if the mutations of this type are executed in an internal transaction
+// in case the client is not transactional, in order to write their outbox events or history
+// records atomically with them.
+func (t Type) HasMutationTx() bool {
+	return t.HasOutbox() || t.HistoryTable() != ""
+}
+
+// VersionField returns the field that is used for optimistic locking, or nil
+// if the type is not versioned.
+func (t Type) VersionField() *Field {
+	if t.IsView() || t.Storage == nil || t.Storage.Name != "sql" {
+		return nil
+	}
+	for _, f := range t.Fields {
+		if f.IsVersion() {
+			return f
+		}
+	}
+	return nil
+}
+
+// checkVersion checks the version field of the type.
+func (t *Type) checkVersion() error {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.IsVersion() {
+			fields = append(fields, f)
+		}
+	}
+	switch {
+	case len(fields) == 0:
+		return nil
+	case len(fields) > 1:
+		return fmt.Errorf("multiple version fields: %q and %q", fields[0].Name, fields[1].Name)
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("version fields are not supported by storage driver %q", t.Storage.Name)
+	case !t.HasOneFieldID():
+		return fmt.Errorf("version fields are not supported by types with composite identifiers")
+	case !fields[0].Type.Type.Integer():
+		return fmt.Errorf("version field %q must be an integer", fields[0].Name)
+	case fields[0].Optional || fields[0].Nillable:
+		return fmt.Errorf("version field %q must not be optional or nillable", fields[0].Name)
+	}
+	return nil
+}
+
+// TenantField returns the field that holds the tenant of the type rows, or
+// nil if the type is not isolated by the "sql/tenancy" feature.
+func (t Type) TenantField() *Field {
+	if t.Config == nil || !t.featureEnabled(FeatureTenancy) {
+		return nil
+	}
+	ant := t.EntSQL()
+	if ant == nil || ant.Tenant == "" {
+		return nil
+	}
+	for _, f := range t.Fields {
+		if f.Name == ant.Tenant {
+			return f
+		}
+	}
+	return nil
+}
+
 // NumM2M returns the type's many-to-many edge count
 func (t Type) NumM2M() int {
 	var n int
@@ -706,6 +1142,9 @@ func (t *Type) setupFKs() error {
 		if ef := e.def.Field; ef != "" && !e.OwnFK() {
 			return fmt.Errorf("edge %q has a field %q but it is not holding a foreign key", e.Name, ef)
 		}
+		if e.M2M() && (t.HasCompositeID() || e.Type.HasCompositeID()) {
+			return fmt.Errorf("edge %q: M2M edges are not supported by types with composite identifiers", e.Name)
+		}
 		if e.IsInverse() || e.M2M() {
 			continue
 		}
@@ -713,6 +1152,9 @@ func (t *Type) setupFKs() error {
 		if !e.OwnFK() {
 			owner, refid = e.Type, t.ID
 		}
+		if refid == nil {
+			return fmt.Errorf("edge %q: foreign keys that reference composite identifiers are not supported. The foreign key must reside in the table of the type with the composite identifier", e.Name)
+		}
 		fk := &ForeignKey{
 			Edge: e,
 			Field: &Field{
@@ -778,6 +1220,12 @@ func (t *Type) setupFieldEdge(fk *ForeignKey, fkOwner *Edge, fkName string) erro
 		return fmt.Errorf("edge %q was set as Immutable, but edge-field %q is not", fkOwner.Name, fkName)
 	case tf.HasValueScanner():
 		return fmt.Errorf("edge-field %q cannot have an external ValueScanner", fkName)
+	case tf.IsGenerated():
+		return fmt.Errorf("edge-field %q cannot be a generated field", fkName)
+	case tf.IsComputed():
+		return fmt.Errorf("edge-field %q cannot be a computed field", fkName)
+	case tf.IsEncrypted():
+		return fmt.Errorf("edge-field %q cannot be an encrypted field", fkName)
 	}
 	if t1, t2 := tf.Type.Type, fkOwner.Type.ID.Type.Type; t1 != t2 {
 		return fmt.Errorf("mismatch field type between edge field %q and id of type %q (%s != %s)", fkName, fkOwner.Type.Name, t1, t2)
@@ -871,6 +1319,16 @@ func (t Type) UpdateOneReceiver() string {
 	return "_u"
 }
 
+// UpdateBulkName returns the struct name denoting the update-bulk-builder for this type.
+func (t Type) UpdateBulkName() string {
+	return pascal(t.Name) + "UpdateBulk"
+}
+
+// UpdateBulkReceiver returns the receiver name of the update-bulk-builder for this type.
+func (t Type) UpdateBulkReceiver() string {
+	return "_u"
+}
+
 // DeleteName returns the struct name denoting the delete-builder for this type.
 func (t Type) DeleteName() string {
 	return pascal(t.Name) + "Delete"
@@ -1041,10 +1499,114 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		err = fmt.Errorf("field %q cannot have both default value and default expression annotations", f.Name)
 	case tf.HasValueScanner() && tf.IsJSON():
 		err = fmt.Errorf("json field %q cannot have an external ValueScanner", f.Name)
+	case f.Computed && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("computed field %q is not supported by storage driver %q", f.Name, t.Storage.Name)
+	case f.Computed && (f.Default || f.UpdateDefault || f.Validators > 0):
+		err = fmt.Errorf("computed field %q cannot have default values or validators", f.Name)
+	case f.Computed && (f.Unique || f.Generated != nil):
+		err = fmt.Errorf("computed field %q cannot be unique or generated", f.Name)
+	case f.Encrypted != nil && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("encrypted field %q is not supported by storage driver %q", f.Name, t.Storage.Name)
+	case f.Encrypted != nil && f.Unique && !f.Encrypted.BlindIndex:
+		err = fmt.Errorf("unique encrypted field %q must have a blind index", f.Name)
+	case f.Encrypted != nil && (f.Computed || f.Generated != nil):
+		err = fmt.Errorf("encrypted field %q cannot be computed or generated", f.Name)
+	case f.Array && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("array field %q is not supported by storage driver %q", f.Name, t.Storage.Name)
+	case f.Array && !tf.SupportsMutationAppend():
+		err = fmt.Errorf("array field %q must be a slice", f.Name)
+	case f.Generated == nil:
+	case f.Generated.Expr == "":
+		err = fmt.Errorf("generated field %q must have an expression", f.Name)
+	case t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("generated field %q is not supported by storage driver %q", f.Name, t.Storage.Name)
+	case f.Default || f.UpdateDefault:
+		err = fmt.Errorf("generated field %q cannot have default values", f.Name)
+	case f.Validators > 0:
+		err = fmt.Errorf("generated field %q cannot have validators", f.Name)
 	}
 	return err
 }
 
+// checkSoftDelete checks the soft-delete configuration of the type.
+func (t *Type) checkSoftDelete() error {
+	ant := softDeleteAnnotate(t.Annotations)
+	if ant == nil {
+		return nil
+	}
+	f, ok := t.fields[ant.Field]
+	switch {
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("soft delete is not supported by storage driver %q", t.Storage.Name)
+	case t.IsView():
+		return errors.New("soft delete cannot be configured on views")
+	case t.HasCompositeID():
+		return errors.New("soft delete cannot be configured on types with composite identifiers")
+	case !ok:
+		return fmt.Errorf("soft delete field %q was not found", ant.Field)
+	case !f.IsTime():
+		return fmt.Errorf("soft delete field %q must be a time field", f.Name)
+	case !f.Optional || f.Immutable:
+		return fmt.Errorf("soft delete field %q must be optional and mutable", f.Name)
+	}
+	return nil
+}
+
+// checkHistory checks the history configuration of the type.
+func (t *Type) checkHistory() error {
+	if t.HistoryTable() == "" {
+		return nil
+	}
+	if t.Storage != nil && t.Storage.Name != "sql" {
+		return fmt.Errorf("history is not supported by storage driver %q", t.Storage.Name)
+	}
+	if t.HasCompositeID() {
+		return errors.New("history cannot be configured on types with composite identifiers")
+	}
+	for _, f := range t.Fields {
+		if strings.HasPrefix(f.StorageKey(), "history_") {
+			return fmt.Errorf("field %q conflicts with the history table columns", f.Name)
+		}
+	}
+	return nil
+}
+
+// checkOutbox checks the outbox configuration of the type.
+func (t *Type) checkOutbox() error {
+	switch {
+	case !t.HasOutbox():
+		return nil
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("outbox is not supported by storage driver %q", t.Storage.Name)
+	case t.HasCompositeID():
+		return errors.New("outbox cannot be configured on types with composite identifiers")
+	}
+	return nil
+}
+
+// checkTenancy checks the tenancy configuration of the type.
+func (t *Type) checkTenancy() error {
+	if t.Config == nil || !t.featureEnabled(FeatureTenancy) {
+		return nil
+	}
+	ant := t.EntSQL()
+	if ant == nil || ant.Tenant == "" {
+		return nil
+	}
+	f := t.TenantField()
+	switch {
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("tenancy is not supported by storage driver %q", t.Storage.Name)
+	case f == nil:
+		return fmt.Errorf("tenant field %q was not found", ant.Tenant)
+	case f.IsEnum(), f.IsJSON(), !f.Type.Comparable():
+		return fmt.Errorf("tenant field %q must be a comparable, non-enum field", f.Name)
+	case f.HasValueScanner():
+		return fmt.Errorf("tenant field %q must not have a custom ValueScanner", f.Name)
+	}
+	return nil
+}
+
 // UnexportedForeignKeys returns all foreign-keys that belong to the type
 // but are not exported (not defined with field). i.e. generated by ent.
 func (t Type) UnexportedForeignKeys() []*ForeignKey {
@@ -1189,9 +1751,24 @@ func (f Field) EntSQL() *entsql.Annotation {
 	return sqlAnnotate(f.Annotations)
 }
 
+// StructField returns the struct field name of the embedded field in the generated entity.
+func (e Embedded) StructField() string {
+	return pascal(e.Name)
+}
+
+// Validator returns the name of the validator of the struct.
+func (e Embedded) Validator() string {
+	return pascal(e.Name) + "Validator"
+}
+
+// Comment returns the comment of the embedded field.
+func (e Embedded) Comment() string {
+	return e.def.Comment
+}
+
 // mutMethods returns the method names of mutation interface.
 var mutMethods = func() map[string]bool {
-	names := map[string]bool{"Client": true, "Tx": true, "Where": true, "SetOp": true}
+	names := map[string]bool{"Client": true, "Tx": true, "Where": true, "SetOp": true, "String": true}
 	t := reflect.TypeOf(new(ent.Mutation)).Elem()
 	for i := 0; i < t.NumMethod(); i++ {
 		names[t.Method(i).Name] = true
@@ -1294,6 +1871,27 @@ func (f Field) MutationAppended() string {
 	return name
 }
 
+// MutationRemove returns the method name for removing a list of values from an array field.
+// The default name is "Remove<FieldName>". If the method conflicts with the mutation methods,
+// suffix the method with "Field".
+func (f Field) MutationRemove() string {
+	name := "Remove" + f.StructField()
+	if mutMethods[name] {
+		name += "Field"
+	}
+	return name
+}
+
+// MutationRemoved returns the method name for getting the list of values
+// that were removed from the field.
+func (f Field) MutationRemoved() string {
+	name := "Removed" + f.StructField()
+	if mutMethods[name] {
+		name += "Field"
+	}
+	return name
+}
+
 // RequiredFor returns a list of dialects that this field is required for.
 // A field can be required in one database, but optional in the other. e.g.,
 // in case a SchemaType was defined as "serial" for PostgreSQL, but "int" for SQLite.
@@ -1353,10 +1951,56 @@ func (f Field) IsInt64() bool { return f.Type != nil && f.Type.Type == field.Typ
 // IsEnum returns true if the field is an enum field.
 func (f Field) IsEnum() bool { return f.Type != nil && f.Type.Type == field.TypeEnum }
 
+// IsGenerated reports if the field is a generated column that is computed by the
+// database. Generated fields are read-only, and they are not part of the mutation.
+func (f Field) IsGenerated() bool { return f.def != nil && f.def.Generated != nil }
+
+// IsComputed reports if the field is computed by an SQL expression on query,
+// and is not stored in the database.
+func (f Field) IsComputed() bool { return f.def != nil && f.def.Computed }
+
+// ComputedName returns the variable name of the SQL expression of the computed field.
+func (f Field) ComputedName() string { return pascal(f.Name) + "Expr" }
+
+// IsEncrypted reports if the field is encrypted on write and decrypted on scan.
+func (f Field) IsEncrypted() bool { return f.def != nil && f.def.Encrypted != nil }
+
+// IsArray reports if the field is stored in a native array column in PostgreSQL,
+// and in a JSON column in other dialects.
+func (f Field) IsArray() bool { return f.def != nil && f.def.Array }
+
+// ArrayElemType returns the Go type of the elements of the array field.
+func (f Field) ArrayElemType() string { return strings.TrimPrefix(f.Type.String(), "[]") }
+
+// HasBlindIndex reports if the encrypted field has a blind-index column.
+func (f Field) HasBlindIndex() bool { return f.IsEncrypted() && f.def.Encrypted.BlindIndex }
+
+// BlindIndexColumn returns the name of the blind-index column of the encrypted field.
+func (f Field) BlindIndexColumn() string { return f.StorageKey() + "_bidx" }
+
+// BlindIndexSchemaColumn returns the blind-index column of the encrypted field. The column
+// holds the hex-encoded HMAC-SHA256 of the field value, and it is unique if the field is unique.
+func (f Field) BlindIndexSchemaColumn() *schema.Column {
+	return &schema.Column{
+		Name:     f.BlindIndexColumn(),
+		Type:     field.TypeString,
+		Size:     64,
+		Unique:   f.Unique,
+		Nullable: true,
+		Comment:  "blind index of the " + f.Name + " field",
+	}
+}
+
+// BlindIndexConstant returns the constant name of the blind-index column of the encrypted field.
+func (f Field) BlindIndexConstant() string { return f.StructField() + "BlindIndexColumn" }
+
 // IsEdgeField reports if the given field is an edge-field (i.e. a foreign-key)
 // that was referenced by one of the edges.
 func (f Field) IsEdgeField() bool { return f.fk != nil }
 
+// IsPolymorphicField reports if the field holds the type or the ID of a polymorphic edge.
+func (f Field) IsPolymorphicField() bool { return f.poly != nil }
+
 // IsDeprecated returns true if the field is deprecated.
 func (f Field) IsDeprecated() bool { return f.def != nil && f.def.Deprecated }
 
@@ -1382,6 +2026,9 @@ func (f Field) Edge() (*Edge, error) {
 // Sensitive returns true if the field is a sensitive field.
 func (f Field) Sensitive() bool { return f.def != nil && f.def.Sensitive }
 
+// IsVersion returns true if the field is the version field that is used for optimistic locking.
+func (f Field) IsVersion() bool { return f.def != nil && f.def.Version }
+
 // Comment returns the comment of the field,
 func (f Field) Comment() string {
 	if f.def != nil {
@@ -1564,13 +2211,15 @@ func (f Field) Column() *schema.Column {
 	c := &schema.Column{
 		Name:     f.StorageKey(),
 		Type:     f.Type.Type,
-		Unique:   f.Unique,
+		Unique:   f.Unique && !f.IsEncrypted(),
 		Nullable: f.Optional,
 		Size:     f.size(),
 		Enums:    f.EnumValues(),
 		Comment:  f.sqlComment(),
 	}
 	switch {
+	// Default values of encrypted fields are encrypted by the builders.
+	case f.IsEncrypted():
 	case f.Default && (f.Type.Numeric() || f.Type.Type == field.TypeBool):
 		c.Default = f.DefaultValue()
 	case f.Default && (f.IsString() || f.IsEnum()):
@@ -1600,10 +2249,27 @@ func (f Field) Column() *schema.Column {
 	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
+		c.Generated = f.def.Generated
+	}
+	// Array fields are stored in native array columns in PostgreSQL,
+	// unless a custom schema type was defined for this dialect.
+	if t, ok := arrayTypes[f.ArrayElemType()]; ok && f.IsArray() && c.SchemaType[dialect.Postgres] == "" {
+		c.SchemaType = make(map[string]string, len(c.SchemaType)+1)
+		for k, v := range f.def.SchemaType {
+			c.SchemaType[k] = v
+		}
+		c.SchemaType[dialect.Postgres] = t
 	}
 	return c
 }
 
+// arrayTypes maps the element types of array fields to their PostgreSQL array types.
+var arrayTypes = map[string]string{
+	"string":  "text[]",
+	"int":     "bigint[]",
+	"float64": "double precision[]",
+}
+
 // incremental returns if the column has an incremental behavior.
 // If no value is defined externally, we use a provided def flag.
 func (f Field) incremental(def bool) bool {
@@ -1618,6 +2284,11 @@ func (f Field) size() int64 {
 	if ant := f.EntSQL(); ant != nil && ant.Size != 0 {
 		return ant.Size
 	}
+	// Encrypted fields hold the ciphertext, which is longer than the plaintext
+	// and depends on the keyring. Hence, they are stored in TEXT or BLOB columns.
+	if f.IsEncrypted() {
+		return math.MaxInt32
+	}
 	if f.def != nil && f.def.Size != nil {
 		return *f.def.Size
 	}
@@ -1733,7 +2404,7 @@ func (f Field) SignedType() (*field.TypeInfo, error) {
 
 // SupportsMutationAdd reports if the field supports the mutation "Add(T) T" interface.
 func (f Field) SupportsMutationAdd() bool {
-	if !f.Type.Numeric() || f.IsEdgeField() {
+	if !f.Type.Numeric() || f.IsEdgeField() || f.IsPolymorphicField() {
 		return false
 	}
 	return f.ConvertedToBasic() || f.implementsAdder()
@@ -1882,7 +2553,7 @@ func (f Field) enums(lf *load.Field) ([]Enum, error) {
 // Ops returns all predicate operations of the field.
 func (f *Field) Ops() []Op {
 	ops := fieldOps(f)
-	if (f.Name != "id" || !f.HasGoType()) && f.cfg != nil && f.cfg.Storage.Ops != nil {
+	if (f.Name != "id" || !f.HasGoType()) && !f.IsComputed() && !f.IsEncrypted() && f.cfg != nil && f.cfg.Storage.Ops != nil {
 		ops = append(ops, f.cfg.Storage.Ops(f)...)
 	}
 	return ops
@@ -1961,6 +2632,22 @@ func (e Edge) EagerLoadField() string {
 	return "with" + e.StructField()
 }
 
+// TypeField returns the field that holds the type of the neighbor of a polymorphic edge.
+func (e Edge) TypeField() *Field {
+	return e.Owner.fields[polymorphicField(e.Name, "type")]
+}
+
+// IDField returns the field that holds the ID of the neighbor of a polymorphic edge.
+func (e Edge) IDField() *Field {
+	return e.Owner.fields[polymorphicField(e.Name, "id")]
+}
+
+// InterfaceName returns the name of the interface that is implemented
+// by the types of a polymorphic edge (e.g. CommentTarget).
+func (e Edge) InterfaceName() string {
+	return e.Owner.Name + e.StructField()
+}
+
 // EagerLoadNamedField returns the struct field (of query builder)
 // for storing the eager-loading info for named edges.
 func (e Edge) EagerLoadNamedField() string {
@@ -2257,6 +2944,18 @@ func sqlAnnotate(annotation map[string]any) *entsql.Annotation {
 	return annotate
 }
 
+// softDeleteAnnotate extracts the mixin.SoftDeleteAnnotation from a loaded annotation format.
+func softDeleteAnnotate(annotation map[string]any) *mixin.SoftDeleteAnnotation {
+	annotate := &mixin.SoftDeleteAnnotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return nil
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
 // sqlIndexAnnotate extracts the entsql annotation from a loaded annotation format.
 func sqlIndexAnnotate(annotation map[string]any) *entsql.IndexAnnotation {
 	annotate := &entsql.IndexAnnotation{}
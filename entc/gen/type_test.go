@@ -5,6 +5,7 @@
 package gen
 
 import (
+	"reflect"
 	"testing"
 
 	"entgo.io/ent/entc/load"
@@ -107,6 +108,199 @@ func TestType(t *testing.T) {
 	require.EqualError(err, "schema name conflicts with ent predeclared identifier \"Value\"")
 }
 
+func TestType_Embedded(t *testing.T) {
+	addr := &load.Field{
+		Name:       "address",
+		Info:       &field.TypeInfo{Type: field.TypeJSON, Ident: "schema.Address", PkgPath: "entgo.io/ent/schema"},
+		Optional:   true,
+		Validators: 1,
+		Embedded: []*load.Field{
+			{Name: "Street", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "ZipCode", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}}, addr},
+	})
+	require.NoError(t, err)
+	require.Len(t, typ.Fields, 3)
+	require.Len(t, typ.Embedded, 1)
+	em := typ.Embedded[0]
+	require.Equal(t, "Address", em.StructField())
+	require.Equal(t, "AddressValidator", em.Validator())
+	require.Equal(t, []string{"Street", "ZipCode"}, em.Members)
+	require.Equal(t, []*Field{typ.Fields[1], typ.Fields[2]}, em.Fields)
+	require.Equal(t, "address_street", em.Fields[0].Name)
+	require.Equal(t, "address_zip_code", em.Fields[1].Name)
+	require.Equal(t, "AddressZipCode", em.Fields[1].StructField())
+	for _, f := range em.Fields {
+		require.True(t, f.Optional && f.Nillable)
+		require.Equal(t, `json:"-"`, f.StructTag)
+	}
+	require.True(t, typ.HasValidators())
+	require.True(t, typ.HasUpdateCheckers())
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{addr, {Name: "address", Info: &field.TypeInfo{Type: field.TypeString}}},
+	})
+	require.EqualError(t, err, `field "address" redeclared for type "T"`)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "address_street", Info: &field.TypeInfo{Type: field.TypeString}}, addr},
+	})
+	require.EqualError(t, err, `field "address_street" redeclared for type "T"`)
+}
+
+func TestType_Generated(t *testing.T) {
+	full := &load.Field{
+		Name:      "full_name",
+		Info:      &field.TypeInfo{Type: field.TypeString},
+		Generated: &field.Generated{Expr: "first || ' ' || last", Stored: true},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "first", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "last", Info: &field.TypeInfo{Type: field.TypeString}, Immutable: true},
+			full,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, typ.Fields, 3)
+	f := typ.Fields[2]
+	require.True(t, f.IsGenerated())
+	require.True(t, f.Immutable)
+	require.Equal(t, []*Field{f}, typ.GeneratedFields())
+	require.Equal(t, typ.Fields[:2], typ.WritableFields())
+	require.Equal(t, typ.Fields[:2], typ.MutationFields())
+	require.Equal(t, typ.Fields[:1], typ.MutableFields())
+	require.Equal(t, typ.Fields[1:2], typ.ImmutableFields())
+	require.Equal(t, full.Generated, f.Column().Generated)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "full_name", Info: &field.TypeInfo{Type: field.TypeString}, Default: true, Generated: full.Generated}},
+	})
+	require.EqualError(t, err, `generated field "full_name" cannot have default values`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "full_name", Info: &field.TypeInfo{Type: field.TypeString}, Generated: &field.Generated{}}},
+	})
+	require.EqualError(t, err, `generated field "full_name" must have an expression`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}, Generated: full.Generated}},
+	})
+	require.EqualError(t, err, "id field cannot be generated")
+}
+
+func TestType_Computed(t *testing.T) {
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "name_len", Info: &field.TypeInfo{Type: field.TypeInt}, Computed: true},
+			{Name: "upper_name", Info: &field.TypeInfo{Type: field.TypeString}, Computed: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, typ.Fields, 3)
+	f := typ.Fields[2]
+	require.True(t, f.IsComputed())
+	require.True(t, f.Immutable)
+	require.Equal(t, "UpperNameExpr", f.ComputedName())
+	require.Equal(t, typ.Fields[1:], typ.ComputedFields())
+	require.Equal(t, typ.Fields[:1], typ.WritableFields())
+	require.Equal(t, typ.Fields[:1], typ.MutationFields())
+	require.Empty(t, typ.ImmutableFields())
+	require.Equal(t, numericOps, f.Ops())
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "name_len", Info: &field.TypeInfo{Type: field.TypeInt}, Default: true, Computed: true}},
+	})
+	require.EqualError(t, err, `computed field "name_len" cannot have default values or validators`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "name_len", Info: &field.TypeInfo{Type: field.TypeInt}, Unique: true, Computed: true}},
+	})
+	require.EqualError(t, err, `computed field "name_len" cannot be unique or generated`)
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "name_len", Info: &field.TypeInfo{Type: field.TypeInt}, Computed: true}},
+	})
+	require.EqualError(t, err, `computed field "name_len" is not supported by storage driver "gremlin"`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}, Computed: true}},
+	})
+	require.EqualError(t, err, "id field cannot be computed")
+}
+
+func TestType_ArrayFields(t *testing.T) {
+	tags := &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string", RType: &field.RType{Kind: reflect.Slice}}
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "tags", Info: tags, Array: true},
+			{Name: "labels", Info: tags, Array: true, SchemaType: map[string]string{"postgres": "varchar[]"}},
+		},
+	})
+	require.NoError(t, err)
+	f := typ.Fields[0]
+	require.True(t, f.IsArray())
+	require.Equal(t, "string", f.ArrayElemType())
+	require.Equal(t, "RemoveTags", f.MutationRemove())
+	require.Equal(t, "RemovedTags", f.MutationRemoved())
+	require.Equal(t, map[string]string{"postgres": "text[]"}, f.Column().SchemaType)
+	require.Equal(t, map[string]string{"postgres": "varchar[]"}, typ.Fields[1].Column().SchemaType)
+
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "tags", Info: tags, Array: true}},
+	})
+	require.EqualError(t, err, `array field "tags" is not supported by storage driver "gremlin"`)
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "tags", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int"}, Array: true}},
+	})
+	require.EqualError(t, err, `array field "tags" must be a slice`)
+}
+
+func TestType_SensitiveFields(t *testing.T) {
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+			{Name: "token", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true, Computed: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, typ.Fields[1:2], typ.SensitiveFields())
+	require.Equal(t, "GetString", (&Field{Name: "string", typ: typ}).MutationGet())
+}
+
+func TestType_CursorFields(t *testing.T) {
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+			{Name: "ssn", Info: &field.TypeInfo{Type: field.TypeString}, Encrypted: &field.Encryption{}},
+			{Name: "count", Info: &field.TypeInfo{Type: field.TypeInt}, Computed: true},
+			{Name: "tags", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}},
+			{Name: "blob", Info: &field.TypeInfo{Type: field.TypeBytes}},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*Field{typ.Fields[0], typ.Fields[6]}, typ.CursorFields())
+}
+
 func TestType_Label(t *testing.T) {
 	tests := []struct {
 		name  string
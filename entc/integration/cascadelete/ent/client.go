@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/cascadelete/ent/migrate"
@@ -64,6 +65,9 @@ type (
 		hooks *hooks
 		// interceptors to execute on queries.
 		inters *inters
+		// batchSize limits the number of rows inserted in one statement by
+		// CreateBulk. Zero means the limit is derived from the dialect.
+		batchSize int
 	}
 	// Option function to configure the client.
 	Option func(*config)
@@ -128,9 +132,12 @@ var ErrTxStarted = errors.New("ent: cannot start a transaction within a transact
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// If the client is already transactional, Tx returns a nested
+// transaction that is backed by a savepoint. See Tx.Savepoint.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
+		return (&Tx{config: c.config}).Savepoint(ctx, "")
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -169,6 +176,115 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts    *sql.TxOptions
+		retries int
+		backoff func(int) time.Duration
+	}
+)
+
+// TxOptions sets the options (e.g. isolation level) that are used to start the transactions.
+func TxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// TxRetries sets the maximum number of times the transaction is retried
+// after a retryable error. The default is 3, and 0 disables retries.
+func TxRetries(n int) TxOption {
+	return func(c *txConfig) {
+		c.retries = n
+	}
+}
+
+// TxBackoff sets the function that returns the duration to wait before
+// the given retry attempt. The default is an exponential backoff that
+// starts at 10ms and is capped at 1s.
+func TxBackoff(f func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = f
+	}
+}
+
+// WithTx runs fn in a transaction. The transaction is committed if fn returns
+// nil, and rolled back if fn returns an error or panics. If the transaction fails
+// with a retryable error (e.g. serialization failure or deadlock), the whole
+// transaction is retried, and therefore, fn must be safe to call more than once.
+//
+// If the client is already transactional, fn runs in a nested transaction that
+// is not retried, and the transaction options are ignored. Retryable errors are
+// expected to be returned to the outermost transaction, which retries as a whole.
+//
+//	err := client.WithTx(ctx, func(tx *Tx) error {
+//		// Use tx.Client() or the tx.<Node> clients.
+//		return nil
+//	}, TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error, opts ...TxOption) error {
+	cfg := txConfig{retries: 3, backoff: txBackoff}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		cfg.opts, cfg.retries = nil, 0
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.withTx(ctx, fn, cfg.opts)
+		if err == nil || attempt >= cfg.retries || !sqlgraph.IsRetryableError(err) {
+			return err
+		}
+		timer := time.NewTimer(cfg.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w: retrying transaction: %v", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// withTx runs fn in one transaction.
+func (c *Client) withTx(ctx context.Context, fn func(tx *Tx) error, opts *sql.TxOptions) error {
+	var (
+		tx  *Tx
+		err error
+	)
+	if opts != nil {
+		tx, err = c.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Tx(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx.
+func txBackoff(attempt int) time.Duration {
+	return min(10*time.Millisecond<<min(attempt, 7), time.Second)
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -287,6 +403,12 @@ func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
 	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Comment entities,
+// where each entity is updated by its own CommentUpdateOne builder.
+func (c *CommentClient) UpdateBulk(builders ...*CommentUpdateOne) *CommentUpdateBulk {
+	return &CommentUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Comment.
 func (c *CommentClient) Delete() *CommentDelete {
 	mutation := newCommentMutation(c.config, OpDelete)
@@ -333,6 +455,7 @@ func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
 func (c *CommentClient) QueryPost(_m *Comment) *PostQuery {
 	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, id),
@@ -436,6 +559,12 @@ func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
 	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Post entities,
+// where each entity is updated by its own PostUpdateOne builder.
+func (c *PostClient) UpdateBulk(builders ...*PostUpdateOne) *PostUpdateBulk {
+	return &PostUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Post.
 func (c *PostClient) Delete() *PostDelete {
 	mutation := newPostMutation(c.config, OpDelete)
@@ -482,6 +611,7 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 func (c *PostClient) QueryAuthor(_m *Post) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(post.Table, post.FieldID, id),
@@ -498,6 +628,7 @@ func (c *PostClient) QueryAuthor(_m *Post) *UserQuery {
 func (c *PostClient) QueryComments(_m *Post) *CommentQuery {
 	query := (&CommentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(post.Table, post.FieldID, id),
@@ -601,6 +732,12 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of User entities,
+// where each entity is updated by its own UserUpdateOne builder.
+func (c *UserClient) UpdateBulk(builders ...*UserUpdateOne) *UserUpdateBulk {
+	return &UserUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -647,6 +784,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 func (c *UserClient) QueryPosts(_m *User) *PostQuery {
 	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -693,3 +831,13 @@ type (
 		Comment, Post, User []ent.Interceptor
 	}
 )
+
+// BatchSize sets the maximum number of rows inserted in one statement by
+// the CreateBulk builders. Larger batches are split into chunks that are
+// executed in one transaction, regardless of this option, in case they
+// exceed the placeholder limit of the database dialect.
+func BatchSize(n int) Option {
+	return func(c *config) {
+		c.batchSize = n
+	}
+}
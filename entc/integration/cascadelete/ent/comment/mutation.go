@@ -9,6 +9,7 @@ package comment
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -135,6 +136,27 @@ func (m *Mutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. Values of sensitive fields
+// are redacted, and therefore, it is safe to log the mutation.
+func (m *Mutation) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentMutation(op=")
+	builder.WriteString(m.op.String())
+	for _, name := range m.Fields() {
+		builder.WriteString(", ")
+		builder.WriteString(name)
+		builder.WriteByte('=')
+		v, _ := m.Field(name)
+		builder.WriteString(fmt.Sprintf("%v", v))
+	}
+	for _, name := range m.AddedFields() {
+		v, _ := m.AddedField(name)
+		builder.WriteString(fmt.Sprintf(", %s+=%v", name, v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
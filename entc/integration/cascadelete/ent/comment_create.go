@@ -94,7 +94,7 @@ func (_c *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 	_node, _spec := _c.createSpec()
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -166,11 +166,11 @@ func (_c *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs, BatchSize: _c.batchSize}
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -112,8 +112,57 @@ func (_u *CommentUpdate) check() error {
 }
 
 func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SaveReturning executes the query and returns the updated Comment entities. PostgreSQL and SQLite
+// use the RETURNING clause, and MySQL locks the matched rows and queries them after the update.
+func (_u *CommentUpdate) SaveReturning(ctx context.Context) ([]*Comment, error) {
+	return withHooks(ctx, _u.sqlSaveReturning, _u.mutation, _u.hooks)
+}
+
+func (_u *CommentUpdate) sqlSaveReturning(ctx context.Context) (nodes []*Comment, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Comment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Comment{config: _u.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if _, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return nodes, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *CommentUpdate) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	if err := _u.check(); err != nil {
-		return _node, err
+		return nil, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	if ps := _u.mutation.Predicates(); len(ps) > 0 {
@@ -155,16 +204,7 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{comment.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
+	return _spec, nil
 }
 
 // CommentUpdateOne is the builder for updating a single Comment entity.
@@ -268,8 +308,29 @@ func (_u *CommentUpdateOne) check() error {
 }
 
 func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_node = &Comment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *CommentUpdateOne) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	if err := _u.check(); err != nil {
-		return _node, err
+		return nil, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
@@ -328,17 +389,91 @@ func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Comment{config: _u.config}
-	_spec.Assign = _node.assignValues
-	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{comment.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	return _spec, nil
+}
+
+// CommentUpdateBulk is the builder for updating many Comment entities in bulk,
+// where each entity is updated by its own CommentUpdateOne builder.
+type CommentUpdateBulk struct {
+	config
+	builders []*CommentUpdateOne
+}
+
+// Save updates the Comment entities in the database, and returns them in the order of their builders.
+// The hooks of each builder are executed on its mutation, and the entities that change the same fields are
+// updated using one statement, or several statements in case the number of arguments exceeds the limit of
+// the database.
+func (_u *CommentUpdateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	specs := make([]*sqlgraph.UpdateSpec, len(_u.builders))
+	nodes := make([]*Comment, len(_u.builders))
+	mutators := make([]Mutator, len(_u.builders))
+	for i := range _u.builders {
+		func(i int, root context.Context) {
+			_builder := _u.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				_builder.mutation = mutation
+				var err error
+				if specs[i], err = _builder.updateSpec(ctx); err != nil {
+					return nil, err
+				}
+				nodes[i] = &Comment{config: _builder.config}
+				specs[i].Assign = nodes[i].assignValues
+				specs[i].ScanValues = nodes[i].scanValues
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _u.builders[i+1].mutation)
+				} else {
+					_spec := &sqlgraph.BatchUpdateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchUpdate(ctx, _u.driver, _spec); err != nil {
+						if _, ok := err.(*sqlgraph.NotFoundError); ok {
+							err = &NotFoundError{comment.Label}
+						} else if sqlgraph.IsConstraintError(err) {
+							err = newConstraintError(err)
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(_builder.hooks) - 1; i >= 0; i-- {
+				mut = _builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _u.builders[0].mutation); err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommentUpdateBulk) SaveX(ctx context.Context) []*Comment {
+	v, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_u *CommentUpdateBulk) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommentUpdateBulk) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -147,6 +147,55 @@ func (_u *PostUpdate) ExecX(ctx context.Context) {
 }
 
 func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{post.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SaveReturning executes the query and returns the updated Post entities. PostgreSQL and SQLite
+// use the RETURNING clause, and MySQL locks the matched rows and queries them after the update.
+func (_u *PostUpdate) SaveReturning(ctx context.Context) ([]*Post, error) {
+	return withHooks(ctx, _u.sqlSaveReturning, _u.mutation, _u.hooks)
+}
+
+func (_u *PostUpdate) sqlSaveReturning(ctx context.Context) (nodes []*Post, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Post).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Post{config: _u.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if _, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{post.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return nodes, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *PostUpdate) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	_spec := sqlgraph.NewUpdateSpec(post.Table, post.Columns, sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt))
 	if ps := _u.mutation.Predicates(); len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -232,16 +281,7 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
+	return _spec, nil
 }
 
 // PostUpdateOne is the builder for updating a single Post entity.
@@ -379,6 +419,27 @@ func (_u *PostUpdateOne) ExecX(ctx context.Context) {
 }
 
 func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_node = &Post{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{post.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *PostUpdateOne) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	_spec := sqlgraph.NewUpdateSpec(post.Table, post.Columns, sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -481,17 +542,91 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Post{config: _u.config}
-	_spec.Assign = _node.assignValues
-	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	return _spec, nil
+}
+
+// PostUpdateBulk is the builder for updating many Post entities in bulk,
+// where each entity is updated by its own PostUpdateOne builder.
+type PostUpdateBulk struct {
+	config
+	builders []*PostUpdateOne
+}
+
+// Save updates the Post entities in the database, and returns them in the order of their builders.
+// The hooks of each builder are executed on its mutation, and the entities that change the same fields are
+// updated using one statement, or several statements in case the number of arguments exceeds the limit of
+// the database.
+func (_u *PostUpdateBulk) Save(ctx context.Context) ([]*Post, error) {
+	specs := make([]*sqlgraph.UpdateSpec, len(_u.builders))
+	nodes := make([]*Post, len(_u.builders))
+	mutators := make([]Mutator, len(_u.builders))
+	for i := range _u.builders {
+		func(i int, root context.Context) {
+			_builder := _u.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				_builder.mutation = mutation
+				var err error
+				if specs[i], err = _builder.updateSpec(ctx); err != nil {
+					return nil, err
+				}
+				nodes[i] = &Post{config: _builder.config}
+				specs[i].Assign = nodes[i].assignValues
+				specs[i].ScanValues = nodes[i].scanValues
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _u.builders[i+1].mutation)
+				} else {
+					_spec := &sqlgraph.BatchUpdateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchUpdate(ctx, _u.driver, _spec); err != nil {
+						if _, ok := err.(*sqlgraph.NotFoundError); ok {
+							err = &NotFoundError{post.Label}
+						} else if sqlgraph.IsConstraintError(err) {
+							err = newConstraintError(err)
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(_builder.hooks) - 1; i >= 0; i-- {
+				mut = _builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _u.builders[0].mutation); err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostUpdateBulk) SaveX(ctx context.Context) []*Post {
+	v, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_u *PostUpdateBulk) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostUpdateBulk) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
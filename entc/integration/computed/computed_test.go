@@ -0,0 +1,57 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package computed
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/entc/integration/computed/ent"
+	"entgo.io/ent/entc/integration/computed/ent/enttest"
+	"entgo.io/ent/entc/integration/computed/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputed(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	client.User.CreateBulk(
+		client.User.Create().SetName("a").SetAge(12),
+		client.User.Create().SetName("b").SetAge(17),
+		client.User.Create().SetName("c").SetAge(31),
+	).ExecX(ctx)
+
+	u := client.User.Query().Where(user.Name("c")).OnlyX(ctx)
+	require.Equal(t, 3, u.Decade)
+	decades := client.User.Query().Order(user.ByDecade(), user.ByName()).Select(user.FieldDecade).IntsX(ctx)
+	require.Equal(t, []int{1, 1, 3}, decades)
+
+	var groups []struct {
+		Decade int `json:"decade"`
+		Count  int `json:"count"`
+	}
+	client.User.Query().
+		Order(user.ByDecade()).
+		GroupBy(user.FieldDecade).
+		Aggregate(ent.Count()).
+		ScanX(ctx, &groups)
+	require.Len(t, groups, 2)
+	require.Equal(t, 1, groups[0].Decade)
+	require.Equal(t, 2, groups[0].Count)
+	require.Equal(t, 3, groups[1].Decade)
+	require.Equal(t, 1, groups[1].Count)
+
+	// Computed fields are not table columns.
+	_, err := client.User.Query().Order(ent.Asc(user.FieldDecade)).All(ctx)
+	require.EqualError(t, err, `ent: unknown column "decade" for table "users"`)
+	_, err = client.User.Query().Aggregate(ent.Sum(user.FieldDecade)).Int(ctx)
+	require.EqualError(t, err, `ent: unknown column "decade" for table "users"`)
+	_, err = client.User.UpdateOne(u).SetAge(40).Select(user.FieldDecade).Save(ctx)
+	require.EqualError(t, err, `ent: invalid field "decade" for query`)
+}
@@ -104,6 +104,55 @@ func (_u *UserUpdate) ExecX(ctx context.Context) {
 }
 
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SaveReturning executes the query and returns the updated User entities. PostgreSQL and SQLite
+// use the RETURNING clause, and MySQL locks the matched rows and queries them after the update.
+func (_u *UserUpdate) SaveReturning(ctx context.Context) ([]*User, error) {
+	return withHooks(ctx, _u.sqlSaveReturning, _u.mutation, _u.hooks)
+}
+
+func (_u *UserUpdate) sqlSaveReturning(ctx context.Context) (nodes []*User, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*User).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &User{config: _u.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if _, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return nodes, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *UserUpdate) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	if ps := _u.mutation.Predicates(); len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -124,16 +173,7 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LabelCleared() {
 		_spec.ClearField(user.FieldLabel, field.TypeString)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
+	return _spec, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
@@ -230,6 +270,27 @@ func (_u *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_node = &User{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *UserUpdateOne) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -267,17 +328,91 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.LabelCleared() {
 		_spec.ClearField(user.FieldLabel, field.TypeString)
 	}
-	_node = &User{config: _u.config}
-	_spec.Assign = _node.assignValues
-	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	return _spec, nil
+}
+
+// UserUpdateBulk is the builder for updating many User entities in bulk,
+// where each entity is updated by its own UserUpdateOne builder.
+type UserUpdateBulk struct {
+	config
+	builders []*UserUpdateOne
+}
+
+// Save updates the User entities in the database, and returns them in the order of their builders.
+// The hooks of each builder are executed on its mutation, and the entities that change the same fields are
+// updated using one statement, or several statements in case the number of arguments exceeds the limit of
+// the database.
+func (_u *UserUpdateBulk) Save(ctx context.Context) ([]*User, error) {
+	specs := make([]*sqlgraph.UpdateSpec, len(_u.builders))
+	nodes := make([]*User, len(_u.builders))
+	mutators := make([]Mutator, len(_u.builders))
+	for i := range _u.builders {
+		func(i int, root context.Context) {
+			_builder := _u.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				_builder.mutation = mutation
+				var err error
+				if specs[i], err = _builder.updateSpec(ctx); err != nil {
+					return nil, err
+				}
+				nodes[i] = &User{config: _builder.config}
+				specs[i].Assign = nodes[i].assignValues
+				specs[i].ScanValues = nodes[i].scanValues
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _u.builders[i+1].mutation)
+				} else {
+					_spec := &sqlgraph.BatchUpdateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchUpdate(ctx, _u.driver, _spec); err != nil {
+						if _, ok := err.(*sqlgraph.NotFoundError); ok {
+							err = &NotFoundError{user.Label}
+						} else if sqlgraph.IsConstraintError(err) {
+							err = newConstraintError(err)
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(_builder.hooks) - 1; i >= 0; i-- {
+				mut = _builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _u.builders[0].mutation); err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdateBulk) SaveX(ctx context.Context) []*User {
+	v, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_u *UserUpdateBulk) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdateBulk) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -33,6 +34,8 @@ type BlobQuery struct {
 	withLinks     *BlobQuery
 	withBlobLinks *BlobLinkQuery
 	withFKs       bool
+	// recursive holds the options of a recursive traversal.
+	recursive *sqlgraph.RecursiveSpec
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,7 +88,11 @@ func (_q *BlobQuery) QueryParent() *BlobQuery {
 			sqlgraph.To(blob.Table, blob.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, blob.ParentTable, blob.ParentColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -107,7 +114,11 @@ func (_q *BlobQuery) QueryLinks() *BlobQuery {
 			sqlgraph.To(blob.Table, blob.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, blob.LinksTable, blob.LinksPrimaryKey...),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -331,8 +342,9 @@ func (_q *BlobQuery) Clone() *BlobQuery {
 		withLinks:     _q.withLinks.Clone(),
 		withBlobLinks: _q.withBlobLinks.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		recursive: _q.recursive,
 	}
 }
 
@@ -433,6 +445,15 @@ func (_q *BlobQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if _q.recursive != nil {
+		if _q.path == nil {
+			return errors.New("ent: recursive traversal requires a query of a self-referencing edge")
+		}
+		// The predicate of the visited vertices is set by the checks below.
+		spec := *_q.recursive
+		spec.Predicate = nil
+		_q.recursive = &spec
+	}
 	if _q.path != nil {
 		prev, err := _q.path(ctx)
 		if err != nil {
@@ -469,6 +490,9 @@ func (_q *BlobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blob, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -627,6 +651,9 @@ func (_q *BlobQuery) loadBlobLinks(ctx context.Context, query *BlobLinkQuery, no
 
 func (_q *BlobQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -706,6 +733,39 @@ func (_q *BlobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Recursive configures the query, that was created by traversing a self-referencing edge
+// (e.g. QueryParent), to return all Blobs that are reachable by traversing
+// the edge repeatedly, up to maxDepth levels. A zero maxDepth means there is no limit.
+//
+//	client.Blob.Query().
+//		Where(...).
+//		QueryParent().
+//		Recursive(0).
+//		All(ctx)
+//
+// Cycles in the graph are traversed only once, and each Blob is returned once.
+func (_q *BlobQuery) Recursive(maxDepth int) *BlobQuery {
+	spec := sqlgraph.RecursiveSpec{MaxDepth: maxDepth}
+	if _q.recursive != nil {
+		spec.Depth = _q.recursive.Depth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
+// RecursiveDepth configures the recursive query to select the depth of each Blob
+// into a column with the given name. The depth is the length of the shortest path from the
+// starting Blobs, and it can be read using the Value method of the returned
+// Blobs. Calling RecursiveDepth without Recursive implies no depth limit.
+func (_q *BlobQuery) RecursiveDepth(as string) *BlobQuery {
+	spec := sqlgraph.RecursiveSpec{Depth: as}
+	if _q.recursive != nil {
+		spec.MaxDepth = _q.recursive.MaxDepth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
 // BlobGroupBy is the group-by builder for Blob entities.
 type BlobGroupBy struct {
 	selector
@@ -787,6 +847,9 @@ func (_s *BlobSelect) sqlScan(ctx context.Context, root *BlobQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
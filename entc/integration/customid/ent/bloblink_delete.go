@@ -43,6 +43,12 @@ func (_d *BlobLinkDelete) ExecX(ctx context.Context) int {
 }
 
 func (_d *BlobLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	return _d.sqlDelete(ctx, nil)
+}
+
+// sqlDelete executes the deletion query. If the assign function is
+// not nil, the deleted rows are scanned and passed to it.
+func (_d *BlobLinkDelete) sqlDelete(ctx context.Context, assign func([]string, []any) error) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(bloblink.Table, nil)
 	if ps := _d.mutation.Predicates(); len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -51,9 +57,16 @@ func (_d *BlobLinkDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
+	if assign != nil {
+		_spec.Node.Columns = bloblink.Columns
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*BlobLink).scanValues(nil, columns)
+		}
+		_spec.Assign = assign
+	}
 	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	_d.mutation.done = true
 	return affected, err
@@ -72,6 +72,9 @@ func (_q *BlobLinkQuery) QueryBlob() *BlobQuery {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "blob")
+		}
 		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
@@ -94,6 +97,9 @@ func (_q *BlobLinkQuery) QueryLink() *BlobQuery {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "link")
+		}
 		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
@@ -597,6 +603,9 @@ func (_s *BlobLinkSelect) sqlScan(ctx context.Context, root *BlobLinkQuery, v an
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
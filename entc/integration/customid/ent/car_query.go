@@ -72,6 +72,9 @@ func (_q *CarQuery) QueryOwner() *PetQuery {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
@@ -608,6 +611,9 @@ func (_s *CarSelect) sqlScan(ctx context.Context, root *CarQuery, v any) error {
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
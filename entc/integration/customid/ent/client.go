@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/customid/ent/migrate"
@@ -128,6 +129,9 @@ type (
 		hooks *hooks
 		// interceptors to execute on queries.
 		inters *inters
+		// batchSize limits the number of rows inserted in one statement by
+		// CreateBulk. Zero means the limit is derived from the dialect.
+		batchSize int
 	}
 	// Option function to configure the client.
 	Option func(*config)
@@ -192,9 +196,12 @@ var ErrTxStarted = errors.New("ent: cannot start a transaction within a transact
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// If the client is already transactional, Tx returns a nested
+// transaction that is backed by a savepoint. See Tx.Savepoint.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
+		return (&Tx{config: c.config}).Savepoint(ctx, "")
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -263,6 +270,115 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts    *sql.TxOptions
+		retries int
+		backoff func(int) time.Duration
+	}
+)
+
+// TxOptions sets the options (e.g. isolation level) that are used to start the transactions.
+func TxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// TxRetries sets the maximum number of times the transaction is retried
+// after a retryable error. The default is 3, and 0 disables retries.
+func TxRetries(n int) TxOption {
+	return func(c *txConfig) {
+		c.retries = n
+	}
+}
+
+// TxBackoff sets the function that returns the duration to wait before
+// the given retry attempt. The default is an exponential backoff that
+// starts at 10ms and is capped at 1s.
+func TxBackoff(f func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = f
+	}
+}
+
+// WithTx runs fn in a transaction. The transaction is committed if fn returns
+// nil, and rolled back if fn returns an error or panics. If the transaction fails
+// with a retryable error (e.g. serialization failure or deadlock), the whole
+// transaction is retried, and therefore, fn must be safe to call more than once.
+//
+// If the client is already transactional, fn runs in a nested transaction that
+// is not retried, and the transaction options are ignored. Retryable errors are
+// expected to be returned to the outermost transaction, which retries as a whole.
+//
+//	err := client.WithTx(ctx, func(tx *Tx) error {
+//		// Use tx.Client() or the tx.<Node> clients.
+//		return nil
+//	}, TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error, opts ...TxOption) error {
+	cfg := txConfig{retries: 3, backoff: txBackoff}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		cfg.opts, cfg.retries = nil, 0
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.withTx(ctx, fn, cfg.opts)
+		if err == nil || attempt >= cfg.retries || !sqlgraph.IsRetryableError(err) {
+			return err
+		}
+		timer := time.NewTimer(cfg.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w: retrying transaction: %v", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// withTx runs fn in one transaction.
+func (c *Client) withTx(ctx context.Context, fn func(tx *Tx) error, opts *sql.TxOptions) error {
+	var (
+		tx  *Tx
+		err error
+	)
+	if opts != nil {
+		tx, err = c.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Tx(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx.
+func txBackoff(attempt int) time.Duration {
+	return min(10*time.Millisecond<<min(attempt, 7), time.Second)
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -419,6 +535,12 @@ func (c *AccountClient) UpdateOneID(id sid.ID) *AccountUpdateOne {
 	return &AccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Account entities,
+// where each entity is updated by its own AccountUpdateOne builder.
+func (c *AccountClient) UpdateBulk(builders ...*AccountUpdateOne) *AccountUpdateBulk {
+	return &AccountUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Account.
 func (c *AccountClient) Delete() *AccountDelete {
 	mutation := newAccountMutation(c.config, OpDelete)
@@ -465,6 +587,7 @@ func (c *AccountClient) GetX(ctx context.Context, id sid.ID) *Account {
 func (c *AccountClient) QueryToken(_m *Account) *TokenQuery {
 	query := (&TokenClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, id),
@@ -568,6 +691,12 @@ func (c *BlobClient) UpdateOneID(id uuid.UUID) *BlobUpdateOne {
 	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Blob entities,
+// where each entity is updated by its own BlobUpdateOne builder.
+func (c *BlobClient) UpdateBulk(builders ...*BlobUpdateOne) *BlobUpdateBulk {
+	return &BlobUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Blob.
 func (c *BlobClient) Delete() *BlobDelete {
 	mutation := newBlobMutation(c.config, OpDelete)
@@ -614,13 +743,18 @@ func (c *BlobClient) GetX(ctx context.Context, id uuid.UUID) *Blob {
 func (c *BlobClient) QueryParent(_m *Blob) *BlobQuery {
 	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(blob.Table, blob.FieldID, id),
 			sqlgraph.To(blob.Table, blob.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, blob.ParentTable, blob.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -630,13 +764,18 @@ func (c *BlobClient) QueryParent(_m *Blob) *BlobQuery {
 func (c *BlobClient) QueryLinks(_m *Blob) *BlobQuery {
 	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(blob.Table, blob.FieldID, id),
 			sqlgraph.To(blob.Table, blob.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, blob.LinksTable, blob.LinksPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -646,6 +785,7 @@ func (c *BlobClient) QueryLinks(_m *Blob) *BlobQuery {
 func (c *BlobClient) QueryBlobLinks(_m *Blob) *BlobLinkQuery {
 	query := (&BlobLinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(blob.Table, blob.FieldID, id),
@@ -658,6 +798,73 @@ func (c *BlobClient) QueryBlobLinks(_m *Blob) *BlobLinkQuery {
 	return query
 }
 
+// Path returns the IDs of the Blobs along the shortest path from the Blob with
+// the from ID to the Blob with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Blob is not reachable from the from Blob.
+//
+//	ids, err := client.Blob.Path(ctx, a.ID, b.ID, blob.EdgeParent)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *BlobClient) Path(ctx context.Context, from, to uuid.UUID, edges ...string) ([]uuid.UUID, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{blob.Label}
+		}
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(path))
+	for i := range path {
+		ids[i] = path[i].(uuid.UUID)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Blob with the to ID is reachable from the Blob with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *BlobClient) Reachable(ctx context.Context, from, to uuid.UUID, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *BlobClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{blob.EdgeParent, blob.EdgeLinks}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case blob.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(blob.Table, blob.FieldID),
+				sqlgraph.To(blob.Table, blob.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, blob.ParentTable, blob.ParentColumn),
+			)
+			steps[i] = step
+		case blob.EdgeLinks:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(blob.Table, blob.FieldID),
+				sqlgraph.To(blob.Table, blob.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, blob.LinksTable, blob.LinksPrimaryKey...),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Blob", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *BlobClient) Hooks() []Hook {
 	return c.hooks.Blob
@@ -865,6 +1072,12 @@ func (c *CarClient) UpdateOneID(id int) *CarUpdateOne {
 	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Car entities,
+// where each entity is updated by its own CarUpdateOne builder.
+func (c *CarClient) UpdateBulk(builders ...*CarUpdateOne) *CarUpdateBulk {
+	return &CarUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Car.
 func (c *CarClient) Delete() *CarDelete {
 	mutation := newCarMutation(c.config, OpDelete)
@@ -911,6 +1124,10 @@ func (c *CarClient) GetX(ctx context.Context, id int) *Car {
 func (c *CarClient) QueryOwner(_m *Car) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(car.Table, car.FieldID, id),
@@ -1014,6 +1231,12 @@ func (c *DeviceClient) UpdateOneID(id schema.ID) *DeviceUpdateOne {
 	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Device entities,
+// where each entity is updated by its own DeviceUpdateOne builder.
+func (c *DeviceClient) UpdateBulk(builders ...*DeviceUpdateOne) *DeviceUpdateBulk {
+	return &DeviceUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Device.
 func (c *DeviceClient) Delete() *DeviceDelete {
 	mutation := newDeviceMutation(c.config, OpDelete)
@@ -1060,6 +1283,7 @@ func (c *DeviceClient) GetX(ctx context.Context, id schema.ID) *Device {
 func (c *DeviceClient) QueryActiveSession(_m *Device) *SessionQuery {
 	query := (&SessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(device.Table, device.FieldID, id),
@@ -1076,6 +1300,7 @@ func (c *DeviceClient) QueryActiveSession(_m *Device) *SessionQuery {
 func (c *DeviceClient) QuerySessions(_m *Device) *SessionQuery {
 	query := (&SessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(device.Table, device.FieldID, id),
@@ -1179,6 +1404,12 @@ func (c *DocClient) UpdateOneID(id schema.DocID) *DocUpdateOne {
 	return &DocUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Doc entities,
+// where each entity is updated by its own DocUpdateOne builder.
+func (c *DocClient) UpdateBulk(builders ...*DocUpdateOne) *DocUpdateBulk {
+	return &DocUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Doc.
 func (c *DocClient) Delete() *DocDelete {
 	mutation := newDocMutation(c.config, OpDelete)
@@ -1225,13 +1456,18 @@ func (c *DocClient) GetX(ctx context.Context, id schema.DocID) *Doc {
 func (c *DocClient) QueryParent(_m *Doc) *DocQuery {
 	query := (&DocClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(doc.Table, doc.FieldID, id),
 			sqlgraph.To(doc.Table, doc.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, doc.ParentTable, doc.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1241,13 +1477,18 @@ func (c *DocClient) QueryParent(_m *Doc) *DocQuery {
 func (c *DocClient) QueryChildren(_m *Doc) *DocQuery {
 	query := (&DocClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(doc.Table, doc.FieldID, id),
 			sqlgraph.To(doc.Table, doc.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, doc.ChildrenTable, doc.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1257,18 +1498,97 @@ func (c *DocClient) QueryChildren(_m *Doc) *DocQuery {
 func (c *DocClient) QueryRelated(_m *Doc) *DocQuery {
 	query := (&DocClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(doc.Table, doc.FieldID, id),
 			sqlgraph.To(doc.Table, doc.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, doc.RelatedTable, doc.RelatedPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the Docs along the shortest path from the Doc with
+// the from ID to the Doc with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Doc is not reachable from the from Doc.
+//
+//	ids, err := client.Doc.Path(ctx, a.ID, b.ID, doc.EdgeParent)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *DocClient) Path(ctx context.Context, from, to schema.DocID, edges ...string) ([]schema.DocID, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{doc.Label}
+		}
+		return nil, err
+	}
+	ids := make([]schema.DocID, len(path))
+	for i := range path {
+		ids[i] = path[i].(schema.DocID)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Doc with the to ID is reachable from the Doc with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *DocClient) Reachable(ctx context.Context, from, to schema.DocID, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *DocClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{doc.EdgeParent, doc.EdgeChildren, doc.EdgeRelated}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case doc.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(doc.Table, doc.FieldID),
+				sqlgraph.To(doc.Table, doc.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, doc.ParentTable, doc.ParentColumn),
+			)
+			steps[i] = step
+		case doc.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(doc.Table, doc.FieldID),
+				sqlgraph.To(doc.Table, doc.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, doc.ChildrenTable, doc.ChildrenColumn),
+			)
+			steps[i] = step
+		case doc.EdgeRelated:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(doc.Table, doc.FieldID),
+				sqlgraph.To(doc.Table, doc.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, doc.RelatedTable, doc.RelatedPrimaryKey...),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Doc", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *DocClient) Hooks() []Hook {
 	return c.hooks.Doc
@@ -1360,6 +1680,12 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Group entities,
+// where each entity is updated by its own GroupUpdateOne builder.
+func (c *GroupClient) UpdateBulk(builders ...*GroupUpdateOne) *GroupUpdateBulk {
+	return &GroupUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -1406,6 +1732,10 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 func (c *GroupClient) QueryUsers(_m *Group) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "users")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -1509,6 +1839,12 @@ func (c *IntSIDClient) UpdateOneID(id sid.ID) *IntSIDUpdateOne {
 	return &IntSIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of IntSID entities,
+// where each entity is updated by its own IntSIDUpdateOne builder.
+func (c *IntSIDClient) UpdateBulk(builders ...*IntSIDUpdateOne) *IntSIDUpdateBulk {
+	return &IntSIDUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for IntSID.
 func (c *IntSIDClient) Delete() *IntSIDDelete {
 	mutation := newIntSIDMutation(c.config, OpDelete)
@@ -1555,13 +1891,18 @@ func (c *IntSIDClient) GetX(ctx context.Context, id sid.ID) *IntSID {
 func (c *IntSIDClient) QueryParent(_m *IntSID) *IntSIDQuery {
 	query := (&IntSIDClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(intsid.Table, intsid.FieldID, id),
 			sqlgraph.To(intsid.Table, intsid.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, false, intsid.ParentTable, intsid.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1571,18 +1912,90 @@ func (c *IntSIDClient) QueryParent(_m *IntSID) *IntSIDQuery {
 func (c *IntSIDClient) QueryChildren(_m *IntSID) *IntSIDQuery {
 	query := (&IntSIDClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(intsid.Table, intsid.FieldID, id),
 			sqlgraph.To(intsid.Table, intsid.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, true, intsid.ChildrenTable, intsid.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the IntSIDs along the shortest path from the IntSID with
+// the from ID to the IntSID with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to IntSID is not reachable from the from IntSID.
+//
+//	ids, err := client.IntSID.Path(ctx, a.ID, b.ID, intsid.EdgeParent)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *IntSIDClient) Path(ctx context.Context, from, to sid.ID, edges ...string) ([]sid.ID, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{intsid.Label}
+		}
+		return nil, err
+	}
+	ids := make([]sid.ID, len(path))
+	for i := range path {
+		ids[i] = path[i].(sid.ID)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the IntSID with the to ID is reachable from the IntSID with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *IntSIDClient) Reachable(ctx context.Context, from, to sid.ID, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *IntSIDClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{intsid.EdgeParent, intsid.EdgeChildren}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case intsid.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(intsid.Table, intsid.FieldID),
+				sqlgraph.To(intsid.Table, intsid.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, intsid.ParentTable, intsid.ParentColumn),
+			)
+			steps[i] = step
+		case intsid.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(intsid.Table, intsid.FieldID),
+				sqlgraph.To(intsid.Table, intsid.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, intsid.ChildrenTable, intsid.ChildrenColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of IntSID", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *IntSIDClient) Hooks() []Hook {
 	return c.hooks.IntSID
@@ -1674,6 +2087,12 @@ func (c *LinkClient) UpdateOneID(id uuidc.UUIDC) *LinkUpdateOne {
 	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Link entities,
+// where each entity is updated by its own LinkUpdateOne builder.
+func (c *LinkClient) UpdateBulk(builders ...*LinkUpdateOne) *LinkUpdateBulk {
+	return &LinkUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Link.
 func (c *LinkClient) Delete() *LinkDelete {
 	mutation := newLinkMutation(c.config, OpDelete)
@@ -1807,6 +2226,12 @@ func (c *MixinIDClient) UpdateOneID(id uuid.UUID) *MixinIDUpdateOne {
 	return &MixinIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of MixinID entities,
+// where each entity is updated by its own MixinIDUpdateOne builder.
+func (c *MixinIDClient) UpdateBulk(builders ...*MixinIDUpdateOne) *MixinIDUpdateBulk {
+	return &MixinIDUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for MixinID.
 func (c *MixinIDClient) Delete() *MixinIDDelete {
 	mutation := newMixinIDMutation(c.config, OpDelete)
@@ -1940,6 +2365,12 @@ func (c *NoteClient) UpdateOneID(id schema.NoteID) *NoteUpdateOne {
 	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Note entities,
+// where each entity is updated by its own NoteUpdateOne builder.
+func (c *NoteClient) UpdateBulk(builders ...*NoteUpdateOne) *NoteUpdateBulk {
+	return &NoteUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Note.
 func (c *NoteClient) Delete() *NoteDelete {
 	mutation := newNoteMutation(c.config, OpDelete)
@@ -1986,13 +2417,18 @@ func (c *NoteClient) GetX(ctx context.Context, id schema.NoteID) *Note {
 func (c *NoteClient) QueryParent(_m *Note) *NoteQuery {
 	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(note.Table, note.FieldID, id),
 			sqlgraph.To(note.Table, note.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, note.ParentTable, note.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2002,18 +2438,90 @@ func (c *NoteClient) QueryParent(_m *Note) *NoteQuery {
 func (c *NoteClient) QueryChildren(_m *Note) *NoteQuery {
 	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(note.Table, note.FieldID, id),
 			sqlgraph.To(note.Table, note.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, note.ChildrenTable, note.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the Notes along the shortest path from the Note with
+// the from ID to the Note with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Note is not reachable from the from Note.
+//
+//	ids, err := client.Note.Path(ctx, a.ID, b.ID, note.EdgeParent)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *NoteClient) Path(ctx context.Context, from, to schema.NoteID, edges ...string) ([]schema.NoteID, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{note.Label}
+		}
+		return nil, err
+	}
+	ids := make([]schema.NoteID, len(path))
+	for i := range path {
+		ids[i] = path[i].(schema.NoteID)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Note with the to ID is reachable from the Note with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *NoteClient) Reachable(ctx context.Context, from, to schema.NoteID, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *NoteClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{note.EdgeParent, note.EdgeChildren}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case note.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(note.Table, note.FieldID),
+				sqlgraph.To(note.Table, note.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, note.ParentTable, note.ParentColumn),
+			)
+			steps[i] = step
+		case note.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(note.Table, note.FieldID),
+				sqlgraph.To(note.Table, note.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, note.ChildrenTable, note.ChildrenColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Note", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *NoteClient) Hooks() []Hook {
 	return c.hooks.Note
@@ -2105,6 +2613,12 @@ func (c *OtherClient) UpdateOneID(id sid.ID) *OtherUpdateOne {
 	return &OtherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Other entities,
+// where each entity is updated by its own OtherUpdateOne builder.
+func (c *OtherClient) UpdateBulk(builders ...*OtherUpdateOne) *OtherUpdateBulk {
+	return &OtherUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Other.
 func (c *OtherClient) Delete() *OtherDelete {
 	mutation := newOtherMutation(c.config, OpDelete)
@@ -2238,6 +2752,12 @@ func (c *PetClient) UpdateOneID(id string) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Pet entities,
+// where each entity is updated by its own PetUpdateOne builder.
+func (c *PetClient) UpdateBulk(builders ...*PetUpdateOne) *PetUpdateBulk {
+	return &PetUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -2284,6 +2804,10 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 func (c *PetClient) QueryOwner(_m *Pet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
@@ -2300,6 +2824,7 @@ func (c *PetClient) QueryOwner(_m *Pet) *UserQuery {
 func (c *PetClient) QueryCars(_m *Pet) *CarQuery {
 	query := (&CarClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
@@ -2316,13 +2841,18 @@ func (c *PetClient) QueryCars(_m *Pet) *CarQuery {
 func (c *PetClient) QueryFriends(_m *Pet) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
 			sqlgraph.To(pet.Table, pet.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, pet.FriendsTable, pet.FriendsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2332,18 +2862,90 @@ func (c *PetClient) QueryFriends(_m *Pet) *PetQuery {
 func (c *PetClient) QueryBestFriend(_m *Pet) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
 			sqlgraph.To(pet.Table, pet.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, pet.BestFriendTable, pet.BestFriendColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the Pets along the shortest path from the Pet with
+// the from ID to the Pet with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Pet is not reachable from the from Pet.
+//
+//	ids, err := client.Pet.Path(ctx, a.ID, b.ID, pet.EdgeFriends)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *PetClient) Path(ctx context.Context, from, to string, edges ...string) ([]string, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{pet.Label}
+		}
+		return nil, err
+	}
+	ids := make([]string, len(path))
+	for i := range path {
+		ids[i] = path[i].(string)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Pet with the to ID is reachable from the Pet with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *PetClient) Reachable(ctx context.Context, from, to string, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *PetClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{pet.EdgeFriends, pet.EdgeBestFriend}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case pet.EdgeFriends:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(pet.Table, pet.FieldID),
+				sqlgraph.To(pet.Table, pet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, pet.FriendsTable, pet.FriendsPrimaryKey...),
+			)
+			steps[i] = step
+		case pet.EdgeBestFriend:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(pet.Table, pet.FieldID),
+				sqlgraph.To(pet.Table, pet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, pet.BestFriendTable, pet.BestFriendColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Pet", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
@@ -2435,6 +3037,12 @@ func (c *RevisionClient) UpdateOneID(id string) *RevisionUpdateOne {
 	return &RevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Revision entities,
+// where each entity is updated by its own RevisionUpdateOne builder.
+func (c *RevisionClient) UpdateBulk(builders ...*RevisionUpdateOne) *RevisionUpdateBulk {
+	return &RevisionUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Revision.
 func (c *RevisionClient) Delete() *RevisionDelete {
 	mutation := newRevisionMutation(c.config, OpDelete)
@@ -2568,6 +3176,12 @@ func (c *SessionClient) UpdateOneID(id schema.ID) *SessionUpdateOne {
 	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Session entities,
+// where each entity is updated by its own SessionUpdateOne builder.
+func (c *SessionClient) UpdateBulk(builders ...*SessionUpdateOne) *SessionUpdateBulk {
+	return &SessionUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Session.
 func (c *SessionClient) Delete() *SessionDelete {
 	mutation := newSessionMutation(c.config, OpDelete)
@@ -2614,6 +3228,7 @@ func (c *SessionClient) GetX(ctx context.Context, id schema.ID) *Session {
 func (c *SessionClient) QueryDevice(_m *Session) *DeviceQuery {
 	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(session.Table, session.FieldID, id),
@@ -2717,6 +3332,12 @@ func (c *TokenClient) UpdateOneID(id sid.ID) *TokenUpdateOne {
 	return &TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Token entities,
+// where each entity is updated by its own TokenUpdateOne builder.
+func (c *TokenClient) UpdateBulk(builders ...*TokenUpdateOne) *TokenUpdateBulk {
+	return &TokenUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Token.
 func (c *TokenClient) Delete() *TokenDelete {
 	mutation := newTokenMutation(c.config, OpDelete)
@@ -2763,6 +3384,7 @@ func (c *TokenClient) GetX(ctx context.Context, id sid.ID) *Token {
 func (c *TokenClient) QueryAccount(_m *Token) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(token.Table, token.FieldID, id),
@@ -2866,6 +3488,12 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of User entities,
+// where each entity is updated by its own UserUpdateOne builder.
+func (c *UserClient) UpdateBulk(builders ...*UserUpdateOne) *UserUpdateBulk {
+	return &UserUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -2912,6 +3540,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2928,13 +3557,18 @@ func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 func (c *UserClient) QueryParent(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, user.ParentTable, user.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2944,13 +3578,18 @@ func (c *UserClient) QueryParent(_m *User) *UserQuery {
 func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, user.ChildrenTable, user.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2960,6 +3599,10 @@ func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 func (c *UserClient) QueryPets(_m *User) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "pets")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2972,6 +3615,73 @@ func (c *UserClient) QueryPets(_m *User) *PetQuery {
 	return query
 }
 
+// Path returns the IDs of the Users along the shortest path from the User with
+// the from ID to the User with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to User is not reachable from the from User.
+//
+//	ids, err := client.User.Path(ctx, a.ID, b.ID, user.EdgeParent)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *UserClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{user.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the User with the to ID is reachable from the User with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *UserClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *UserClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{user.EdgeParent, user.EdgeChildren}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case user.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, user.ParentTable, user.ParentColumn),
+			)
+			steps[i] = step
+		case user.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.ChildrenTable, user.ChildrenColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of User", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3063,6 +3773,12 @@ func (c *ValueScanClient) UpdateOneID(id schema.ValueScanID) *ValueScanUpdateOne
 	return &ValueScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of ValueScan entities,
+// where each entity is updated by its own ValueScanUpdateOne builder.
+func (c *ValueScanClient) UpdateBulk(builders ...*ValueScanUpdateOne) *ValueScanUpdateBulk {
+	return &ValueScanUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for ValueScan.
 func (c *ValueScanClient) Delete() *ValueScanDelete {
 	mutation := newValueScanMutation(c.config, OpDelete)
@@ -3141,3 +3857,13 @@ type (
 		Other, Pet, Revision, Session, Token, User, ValueScan []ent.Interceptor
 	}
 )
+
+// BatchSize sets the maximum number of rows inserted in one statement by
+// the CreateBulk builders. Larger batches are split into chunks that are
+// executed in one transaction, regardless of this option, in case they
+// exceed the placeholder limit of the database dialect.
+func BatchSize(n int) Option {
+	return func(c *config) {
+		c.batchSize = n
+	}
+}
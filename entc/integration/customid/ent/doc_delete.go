@@ -44,6 +44,27 @@ func (_d *DocDelete) ExecX(ctx context.Context) int {
 }
 
 func (_d *DocDelete) sqlExec(ctx context.Context) (int, error) {
+	return _d.sqlDelete(ctx, nil)
+}
+
+// ExecReturning executes the deletion query and returns the deleted Doc entities. PostgreSQL and
+// SQLite use the RETURNING clause, and MySQL selects and locks the matched rows before deleting them.
+func (_d *DocDelete) ExecReturning(ctx context.Context) ([]*Doc, error) {
+	return withHooks(ctx, _d.sqlExecReturning, _d.mutation, _d.hooks)
+}
+
+func (_d *DocDelete) sqlExecReturning(ctx context.Context) (nodes []*Doc, err error) {
+	_, err = _d.sqlDelete(ctx, func(columns []string, values []any) error {
+		node := &Doc{config: _d.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	})
+	return nodes, err
+}
+
+// sqlDelete executes the deletion query. If the assign function is
+// not nil, the deleted rows are scanned and passed to it.
+func (_d *DocDelete) sqlDelete(ctx context.Context, assign func([]string, []any) error) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(doc.Table, sqlgraph.NewFieldSpec(doc.FieldID, field.TypeString))
 	if ps := _d.mutation.Predicates(); len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -52,9 +73,16 @@ func (_d *DocDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
+	if assign != nil {
+		_spec.Node.Columns = doc.Columns
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Doc).scanValues(nil, columns)
+		}
+		_spec.Assign = assign
+	}
 	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	_d.mutation.done = true
 	return affected, err
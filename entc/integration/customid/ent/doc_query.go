@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -32,6 +33,8 @@ type DocQuery struct {
 	withChildren *DocQuery
 	withRelated  *DocQuery
 	withFKs      bool
+	// recursive holds the options of a recursive traversal.
+	recursive *sqlgraph.RecursiveSpec
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,7 +87,11 @@ func (_q *DocQuery) QueryParent() *DocQuery {
 			sqlgraph.To(doc.Table, doc.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, doc.ParentTable, doc.ParentColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -106,7 +113,11 @@ func (_q *DocQuery) QueryChildren() *DocQuery {
 			sqlgraph.To(doc.Table, doc.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, doc.ChildrenTable, doc.ChildrenColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -128,7 +139,11 @@ func (_q *DocQuery) QueryRelated() *DocQuery {
 			sqlgraph.To(doc.Table, doc.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, doc.RelatedTable, doc.RelatedPrimaryKey...),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -330,8 +345,9 @@ func (_q *DocQuery) Clone() *DocQuery {
 		withChildren: _q.withChildren.Clone(),
 		withRelated:  _q.withRelated.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		recursive: _q.recursive,
 	}
 }
 
@@ -432,6 +448,15 @@ func (_q *DocQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if _q.recursive != nil {
+		if _q.path == nil {
+			return errors.New("ent: recursive traversal requires a query of a self-referencing edge")
+		}
+		// The predicate of the visited vertices is set by the checks below.
+		spec := *_q.recursive
+		spec.Predicate = nil
+		_q.recursive = &spec
+	}
 	if _q.path != nil {
 		prev, err := _q.path(ctx)
 		if err != nil {
@@ -468,6 +493,9 @@ func (_q *DocQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -627,6 +655,9 @@ func (_q *DocQuery) loadRelated(ctx context.Context, query *DocQuery, nodes []*D
 
 func (_q *DocQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -706,6 +737,39 @@ func (_q *DocQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Recursive configures the query, that was created by traversing a self-referencing edge
+// (e.g. QueryParent), to return all Docs that are reachable by traversing
+// the edge repeatedly, up to maxDepth levels. A zero maxDepth means there is no limit.
+//
+//	client.Doc.Query().
+//		Where(...).
+//		QueryParent().
+//		Recursive(0).
+//		All(ctx)
+//
+// Cycles in the graph are traversed only once, and each Doc is returned once.
+func (_q *DocQuery) Recursive(maxDepth int) *DocQuery {
+	spec := sqlgraph.RecursiveSpec{MaxDepth: maxDepth}
+	if _q.recursive != nil {
+		spec.Depth = _q.recursive.Depth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
+// RecursiveDepth configures the recursive query to select the depth of each Doc
+// into a column with the given name. The depth is the length of the shortest path from the
+// starting Docs, and it can be read using the Value method of the returned
+// Docs. Calling RecursiveDepth without Recursive implies no depth limit.
+func (_q *DocQuery) RecursiveDepth(as string) *DocQuery {
+	spec := sqlgraph.RecursiveSpec{Depth: as}
+	if _q.recursive != nil {
+		spec.MaxDepth = _q.recursive.MaxDepth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
 // DocGroupBy is the group-by builder for Doc entities.
 type DocGroupBy struct {
 	selector
@@ -787,6 +851,9 @@ func (_s *DocSelect) sqlScan(ctx context.Context, root *DocQuery, v any) error {
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
@@ -302,6 +302,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -642,5 +650,203 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	account.Table: {
+		label: account.Label,
+		fields: map[string]string{
+			account.FieldID:    "id",
+			account.FieldEmail: "email",
+		},
+	},
+	blob.Table: {
+		label: blob.Label,
+		fields: map[string]string{
+			blob.FieldID:    "id",
+			blob.FieldUUID:  "uuid",
+			blob.FieldCount: "count",
+		},
+	},
+	bloblink.Table: {
+		label: bloblink.Label,
+		fields: map[string]string{
+			bloblink.FieldCreatedAt: "created_at",
+			bloblink.FieldBlobID:    "blob_id",
+			bloblink.FieldLinkID:    "link_id",
+		},
+	},
+	car.Table: {
+		label: car.Label,
+		fields: map[string]string{
+			car.FieldID:       "id",
+			car.FieldBeforeID: "before_id",
+			car.FieldAfterID:  "after_id",
+			car.FieldModel:    "model",
+		},
+	},
+	device.Table: {
+		label: device.Label,
+		fields: map[string]string{
+			device.FieldID: "id",
+		},
+	},
+	doc.Table: {
+		label: doc.Label,
+		fields: map[string]string{
+			doc.FieldID:   "id",
+			doc.FieldText: "text",
+		},
+	},
+	group.Table: {
+		label: group.Label,
+		fields: map[string]string{
+			group.FieldID: "id",
+		},
+	},
+	intsid.Table: {
+		label: intsid.Label,
+		fields: map[string]string{
+			intsid.FieldID: "id",
+		},
+	},
+	link.Table: {
+		label: link.Label,
+		fields: map[string]string{
+			link.FieldID:              "id",
+			link.FieldLinkInformation: "link_information",
+		},
+	},
+	mixinid.Table: {
+		label: mixinid.Label,
+		fields: map[string]string{
+			mixinid.FieldID:         "id",
+			mixinid.FieldSomeField:  "some_field",
+			mixinid.FieldMixinField: "mixin_field",
+		},
+	},
+	note.Table: {
+		label: note.Label,
+		fields: map[string]string{
+			note.FieldID:   "id",
+			note.FieldText: "text",
+		},
+	},
+	other.Table: {
+		label: other.Label,
+		fields: map[string]string{
+			other.FieldID: "id",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID: "id",
+		},
+	},
+	revision.Table: {
+		label: revision.Label,
+		fields: map[string]string{
+			revision.FieldID: "id",
+		},
+	},
+	session.Table: {
+		label: session.Label,
+		fields: map[string]string{
+			session.FieldID: "id",
+		},
+	},
+	token.Table: {
+		label: token.Label,
+		fields: map[string]string{
+			token.FieldID:   "id",
+			token.FieldBody: "body",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID: "id",
+		},
+	},
+	valuescan.Table: {
+		label: valuescan.Label,
+		fields: map[string]string{
+			valuescan.FieldID:   "id",
+			valuescan.FieldName: "name",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"blobs_id_key":                    {table: "blobs", columns: []string{"id"}},
+	"blobs_uuid_key":                  {table: "blobs", columns: []string{"uuid"}},
+	"blobs_blob_parent_key":           {table: "blobs", columns: []string{"blob_parent"}},
+	"blobs_blobs_parent":              {table: "blobs", columns: []string{"blob_parent"}},
+	"blob_links_blobs_blob":           {table: "blob_links", columns: []string{"blob_id"}},
+	"blob_links_blobs_link":           {table: "blob_links", columns: []string{"link_id"}},
+	"cars_pets_cars":                  {table: "cars", columns: []string{"pet_cars"}},
+	"devices_sessions_active_session": {table: "devices", columns: []string{"device_active_session"}},
+	"docs_id_key":                     {table: "docs", columns: []string{"id"}},
+	"docs_docs_children":              {table: "docs", columns: []string{"doc_children"}},
+	"int_si_ds_int_si_ds_parent":      {table: "int_si_ds", columns: []string{"int_sid_parent"}},
+	"notes_id_key":                    {table: "notes", columns: []string{"id"}},
+	"notes_notes_children":            {table: "notes", columns: []string{"note_children"}},
+	"pets_id_key":                     {table: "pets", columns: []string{"id"}},
+	"pets_pet_best_friend_key":        {table: "pets", columns: []string{"pet_best_friend"}},
+	"pets_pets_best_friend":           {table: "pets", columns: []string{"pet_best_friend"}},
+	"pets_users_pets":                 {table: "pets", columns: []string{"user_pets"}},
+	"sessions_devices_sessions":       {table: "sessions", columns: []string{"device_sessions"}},
+	"tokens_accounts_token":           {table: "tokens", columns: []string{"account_token"}},
+	"users_users_children":            {table: "users", columns: []string{"user_children"}},
+	"doc_related_doc_id":              {table: "doc_related", columns: []string{"doc_id"}},
+	"doc_related_related_id":          {table: "doc_related", columns: []string{"related_id"}},
+	"group_users_group_id":            {table: "group_users", columns: []string{"group_id"}},
+	"group_users_user_id":             {table: "group_users", columns: []string{"user_id"}},
+	"pet_friends_pet_id":              {table: "pet_friends", columns: []string{"pet_id"}},
+	"pet_friends_friend_id":           {table: "pet_friends", columns: []string{"friend_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -31,6 +32,8 @@ type IntSIDQuery struct {
 	withParent   *IntSIDQuery
 	withChildren *IntSIDQuery
 	withFKs      bool
+	// recursive holds the options of a recursive traversal.
+	recursive *sqlgraph.RecursiveSpec
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,7 +86,11 @@ func (_q *IntSIDQuery) QueryParent() *IntSIDQuery {
 			sqlgraph.To(intsid.Table, intsid.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, false, intsid.ParentTable, intsid.ParentColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -105,7 +112,11 @@ func (_q *IntSIDQuery) QueryChildren() *IntSIDQuery {
 			sqlgraph.To(intsid.Table, intsid.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, true, intsid.ChildrenTable, intsid.ChildrenColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -306,8 +317,9 @@ func (_q *IntSIDQuery) Clone() *IntSIDQuery {
 		withParent:   _q.withParent.Clone(),
 		withChildren: _q.withChildren.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		recursive: _q.recursive,
 	}
 }
 
@@ -375,6 +387,15 @@ func (_q *IntSIDQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if _q.recursive != nil {
+		if _q.path == nil {
+			return errors.New("ent: recursive traversal requires a query of a self-referencing edge")
+		}
+		// The predicate of the visited vertices is set by the checks below.
+		spec := *_q.recursive
+		spec.Predicate = nil
+		_q.recursive = &spec
+	}
 	if _q.path != nil {
 		prev, err := _q.path(ctx)
 		if err != nil {
@@ -410,6 +431,9 @@ func (_q *IntSIDQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IntSI
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -501,6 +525,9 @@ func (_q *IntSIDQuery) loadChildren(ctx context.Context, query *IntSIDQuery, nod
 
 func (_q *IntSIDQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -580,6 +607,39 @@ func (_q *IntSIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Recursive configures the query, that was created by traversing a self-referencing edge
+// (e.g. QueryParent), to return all IntSIDs that are reachable by traversing
+// the edge repeatedly, up to maxDepth levels. A zero maxDepth means there is no limit.
+//
+//	client.IntSID.Query().
+//		Where(...).
+//		QueryParent().
+//		Recursive(0).
+//		All(ctx)
+//
+// Cycles in the graph are traversed only once, and each IntSID is returned once.
+func (_q *IntSIDQuery) Recursive(maxDepth int) *IntSIDQuery {
+	spec := sqlgraph.RecursiveSpec{MaxDepth: maxDepth}
+	if _q.recursive != nil {
+		spec.Depth = _q.recursive.Depth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
+// RecursiveDepth configures the recursive query to select the depth of each IntSID
+// into a column with the given name. The depth is the length of the shortest path from the
+// starting IntSIDs, and it can be read using the Value method of the returned
+// IntSIDs. Calling RecursiveDepth without Recursive implies no depth limit.
+func (_q *IntSIDQuery) RecursiveDepth(as string) *IntSIDQuery {
+	spec := sqlgraph.RecursiveSpec{Depth: as}
+	if _q.recursive != nil {
+		spec.MaxDepth = _q.recursive.MaxDepth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
 // IntSIDGroupBy is the group-by builder for IntSID entities.
 type IntSIDGroupBy struct {
 	selector
@@ -661,6 +721,9 @@ func (_s *IntSIDSelect) sqlScan(ctx context.Context, root *IntSIDQuery, v any) e
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
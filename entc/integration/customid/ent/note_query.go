@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -31,6 +32,8 @@ type NoteQuery struct {
 	withParent   *NoteQuery
 	withChildren *NoteQuery
 	withFKs      bool
+	// recursive holds the options of a recursive traversal.
+	recursive *sqlgraph.RecursiveSpec
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,7 +86,11 @@ func (_q *NoteQuery) QueryParent() *NoteQuery {
 			sqlgraph.To(note.Table, note.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, note.ParentTable, note.ParentColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -105,7 +112,11 @@ func (_q *NoteQuery) QueryChildren() *NoteQuery {
 			sqlgraph.To(note.Table, note.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, note.ChildrenTable, note.ChildrenColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -306,8 +317,9 @@ func (_q *NoteQuery) Clone() *NoteQuery {
 		withParent:   _q.withParent.Clone(),
 		withChildren: _q.withChildren.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		recursive: _q.recursive,
 	}
 }
 
@@ -397,6 +409,15 @@ func (_q *NoteQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if _q.recursive != nil {
+		if _q.path == nil {
+			return errors.New("ent: recursive traversal requires a query of a self-referencing edge")
+		}
+		// The predicate of the visited vertices is set by the checks below.
+		spec := *_q.recursive
+		spec.Predicate = nil
+		_q.recursive = &spec
+	}
 	if _q.path != nil {
 		prev, err := _q.path(ctx)
 		if err != nil {
@@ -432,6 +453,9 @@ func (_q *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -523,6 +547,9 @@ func (_q *NoteQuery) loadChildren(ctx context.Context, query *NoteQuery, nodes [
 
 func (_q *NoteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -602,6 +629,39 @@ func (_q *NoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Recursive configures the query, that was created by traversing a self-referencing edge
+// (e.g. QueryParent), to return all Notes that are reachable by traversing
+// the edge repeatedly, up to maxDepth levels. A zero maxDepth means there is no limit.
+//
+//	client.Note.Query().
+//		Where(...).
+//		QueryParent().
+//		Recursive(0).
+//		All(ctx)
+//
+// Cycles in the graph are traversed only once, and each Note is returned once.
+func (_q *NoteQuery) Recursive(maxDepth int) *NoteQuery {
+	spec := sqlgraph.RecursiveSpec{MaxDepth: maxDepth}
+	if _q.recursive != nil {
+		spec.Depth = _q.recursive.Depth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
+// RecursiveDepth configures the recursive query to select the depth of each Note
+// into a column with the given name. The depth is the length of the shortest path from the
+// starting Notes, and it can be read using the Value method of the returned
+// Notes. Calling RecursiveDepth without Recursive implies no depth limit.
+func (_q *NoteQuery) RecursiveDepth(as string) *NoteQuery {
+	spec := sqlgraph.RecursiveSpec{Depth: as}
+	if _q.recursive != nil {
+		spec.MaxDepth = _q.recursive.MaxDepth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
 // NoteGroupBy is the group-by builder for Note entities.
 type NoteGroupBy struct {
 	selector
@@ -683,6 +743,9 @@ func (_s *NoteSelect) sqlScan(ctx context.Context, root *NoteQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
@@ -500,6 +500,9 @@ func (_s *OtherSelect) sqlScan(ctx context.Context, root *OtherQuery, v any) err
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -34,6 +35,8 @@ type PetQuery struct {
 	withFriends    *PetQuery
 	withBestFriend *PetQuery
 	withFKs        bool
+	// recursive holds the options of a recursive traversal.
+	recursive *sqlgraph.RecursiveSpec
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,6 +80,9 @@ func (_q *PetQuery) QueryOwner() *UserQuery {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
@@ -130,7 +136,11 @@ func (_q *PetQuery) QueryFriends() *PetQuery {
 			sqlgraph.To(pet.Table, pet.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, pet.FriendsTable, pet.FriendsPrimaryKey...),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -152,7 +162,11 @@ func (_q *PetQuery) QueryBestFriend() *PetQuery {
 			sqlgraph.To(pet.Table, pet.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, pet.BestFriendTable, pet.BestFriendColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromU = sqlgraph.RecursiveNeighbors(_q.driver.Dialect(), step, query.recursive)
+		} else {
+			fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		}
 		return fromU, nil
 	}
 	return query
@@ -355,8 +369,9 @@ func (_q *PetQuery) Clone() *PetQuery {
 		withFriends:    _q.withFriends.Clone(),
 		withBestFriend: _q.withBestFriend.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		recursive: _q.recursive,
 	}
 }
 
@@ -446,6 +461,15 @@ func (_q *PetQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if _q.recursive != nil {
+		if _q.path == nil {
+			return errors.New("ent: recursive traversal requires a query of a self-referencing edge")
+		}
+		// The predicate of the visited vertices is set by the checks below.
+		spec := *_q.recursive
+		spec.Predicate = nil
+		_q.recursive = &spec
+	}
 	if _q.path != nil {
 		prev, err := _q.path(ctx)
 		if err != nil {
@@ -483,6 +507,9 @@ func (_q *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -680,6 +707,9 @@ func (_q *PetQuery) loadBestFriend(ctx context.Context, query *PetQuery, nodes [
 
 func (_q *PetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if _q.recursive != nil && _q.recursive.Depth != "" {
+		_spec.Modifiers = append([]func(*sql.Selector){sqlgraph.SelectDepth(_q.recursive)}, _spec.Modifiers...)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -759,6 +789,39 @@ func (_q *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Recursive configures the query, that was created by traversing a self-referencing edge
+// (e.g. QueryFriends), to return all Pets that are reachable by traversing
+// the edge repeatedly, up to maxDepth levels. A zero maxDepth means there is no limit.
+//
+//	client.Pet.Query().
+//		Where(...).
+//		QueryFriends().
+//		Recursive(0).
+//		All(ctx)
+//
+// Cycles in the graph are traversed only once, and each Pet is returned once.
+func (_q *PetQuery) Recursive(maxDepth int) *PetQuery {
+	spec := sqlgraph.RecursiveSpec{MaxDepth: maxDepth}
+	if _q.recursive != nil {
+		spec.Depth = _q.recursive.Depth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
+// RecursiveDepth configures the recursive query to select the depth of each Pet
+// into a column with the given name. The depth is the length of the shortest path from the
+// starting Pets, and it can be read using the Value method of the returned
+// Pets. Calling RecursiveDepth without Recursive implies no depth limit.
+func (_q *PetQuery) RecursiveDepth(as string) *PetQuery {
+	spec := sqlgraph.RecursiveSpec{Depth: as}
+	if _q.recursive != nil {
+		spec.MaxDepth = _q.recursive.MaxDepth
+	}
+	_q.recursive = &spec
+	return _q
+}
+
 // PetGroupBy is the group-by builder for Pet entities.
 type PetGroupBy struct {
 	selector
@@ -840,6 +903,9 @@ func (_s *PetSelect) sqlScan(ctx context.Context, root *PetQuery, v any) error {
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
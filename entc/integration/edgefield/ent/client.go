@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/edgefield/ent/migrate"
@@ -89,6 +90,9 @@ type (
 		hooks *hooks
 		// interceptors to execute on queries.
 		inters *inters
+		// batchSize limits the number of rows inserted in one statement by
+		// CreateBulk. Zero means the limit is derived from the dialect.
+		batchSize int
 	}
 	// Option function to configure the client.
 	Option func(*config)
@@ -153,9 +157,12 @@ var ErrTxStarted = errors.New("ent: cannot start a transaction within a transact
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// If the client is already transactional, Tx returns a nested
+// transaction that is backed by a savepoint. See Tx.Savepoint.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
+		return (&Tx{config: c.config}).Savepoint(ctx, "")
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -206,6 +213,115 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts    *sql.TxOptions
+		retries int
+		backoff func(int) time.Duration
+	}
+)
+
+// TxOptions sets the options (e.g. isolation level) that are used to start the transactions.
+func TxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// TxRetries sets the maximum number of times the transaction is retried
+// after a retryable error. The default is 3, and 0 disables retries.
+func TxRetries(n int) TxOption {
+	return func(c *txConfig) {
+		c.retries = n
+	}
+}
+
+// TxBackoff sets the function that returns the duration to wait before
+// the given retry attempt. The default is an exponential backoff that
+// starts at 10ms and is capped at 1s.
+func TxBackoff(f func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = f
+	}
+}
+
+// WithTx runs fn in a transaction. The transaction is committed if fn returns
+// nil, and rolled back if fn returns an error or panics. If the transaction fails
+// with a retryable error (e.g. serialization failure or deadlock), the whole
+// transaction is retried, and therefore, fn must be safe to call more than once.
+//
+// If the client is already transactional, fn runs in a nested transaction that
+// is not retried, and the transaction options are ignored. Retryable errors are
+// expected to be returned to the outermost transaction, which retries as a whole.
+//
+//	err := client.WithTx(ctx, func(tx *Tx) error {
+//		// Use tx.Client() or the tx.<Node> clients.
+//		return nil
+//	}, TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error, opts ...TxOption) error {
+	cfg := txConfig{retries: 3, backoff: txBackoff}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		cfg.opts, cfg.retries = nil, 0
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.withTx(ctx, fn, cfg.opts)
+		if err == nil || attempt >= cfg.retries || !sqlgraph.IsRetryableError(err) {
+			return err
+		}
+		timer := time.NewTimer(cfg.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w: retrying transaction: %v", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// withTx runs fn in one transaction.
+func (c *Client) withTx(ctx context.Context, fn func(tx *Tx) error, opts *sql.TxOptions) error {
+	var (
+		tx  *Tx
+		err error
+	)
+	if opts != nil {
+		tx, err = c.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Tx(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx.
+func txBackoff(attempt int) time.Duration {
+	return min(10*time.Millisecond<<min(attempt, 7), time.Second)
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -340,6 +456,12 @@ func (c *CarClient) UpdateOneID(id uuid.UUID) *CarUpdateOne {
 	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Car entities,
+// where each entity is updated by its own CarUpdateOne builder.
+func (c *CarClient) UpdateBulk(builders ...*CarUpdateOne) *CarUpdateBulk {
+	return &CarUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Car.
 func (c *CarClient) Delete() *CarDelete {
 	mutation := newCarMutation(c.config, OpDelete)
@@ -386,6 +508,7 @@ func (c *CarClient) GetX(ctx context.Context, id uuid.UUID) *Car {
 func (c *CarClient) QueryRentals(_m *Car) *RentalQuery {
 	query := (&RentalClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(car.Table, car.FieldID, id),
@@ -489,6 +612,12 @@ func (c *CardClient) UpdateOneID(id int) *CardUpdateOne {
 	return &CardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Card entities,
+// where each entity is updated by its own CardUpdateOne builder.
+func (c *CardClient) UpdateBulk(builders ...*CardUpdateOne) *CardUpdateBulk {
+	return &CardUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Card.
 func (c *CardClient) Delete() *CardDelete {
 	mutation := newCardMutation(c.config, OpDelete)
@@ -535,6 +664,10 @@ func (c *CardClient) GetX(ctx context.Context, id int) *Card {
 func (c *CardClient) QueryOwner(_m *Card) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(card.Table, card.FieldID, id),
@@ -638,6 +771,12 @@ func (c *InfoClient) UpdateOneID(id int) *InfoUpdateOne {
 	return &InfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Info entities,
+// where each entity is updated by its own InfoUpdateOne builder.
+func (c *InfoClient) UpdateBulk(builders ...*InfoUpdateOne) *InfoUpdateBulk {
+	return &InfoUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Info.
 func (c *InfoClient) Delete() *InfoDelete {
 	mutation := newInfoMutation(c.config, OpDelete)
@@ -684,6 +823,10 @@ func (c *InfoClient) GetX(ctx context.Context, id int) *Info {
 func (c *InfoClient) QueryUser(_m *Info) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(info.Table, info.FieldID, id),
@@ -787,6 +930,12 @@ func (c *MetadataClient) UpdateOneID(id int) *MetadataUpdateOne {
 	return &MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Metadata entities,
+// where each entity is updated by its own MetadataUpdateOne builder.
+func (c *MetadataClient) UpdateBulk(builders ...*MetadataUpdateOne) *MetadataUpdateBulk {
+	return &MetadataUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Metadata.
 func (c *MetadataClient) Delete() *MetadataDelete {
 	mutation := newMetadataMutation(c.config, OpDelete)
@@ -833,6 +982,10 @@ func (c *MetadataClient) GetX(ctx context.Context, id int) *Metadata {
 func (c *MetadataClient) QueryUser(_m *Metadata) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(metadata.Table, metadata.FieldID, id),
@@ -849,13 +1002,18 @@ func (c *MetadataClient) QueryUser(_m *Metadata) *UserQuery {
 func (c *MetadataClient) QueryChildren(_m *Metadata) *MetadataQuery {
 	query := (&MetadataClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(metadata.Table, metadata.FieldID, id),
 			sqlgraph.To(metadata.Table, metadata.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, true, metadata.ChildrenTable, metadata.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -865,18 +1023,90 @@ func (c *MetadataClient) QueryChildren(_m *Metadata) *MetadataQuery {
 func (c *MetadataClient) QueryParent(_m *Metadata) *MetadataQuery {
 	query := (&MetadataClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(metadata.Table, metadata.FieldID, id),
 			sqlgraph.To(metadata.Table, metadata.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, false, metadata.ParentTable, metadata.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the MetadataSlice along the shortest path from the Metadata with
+// the from ID to the Metadata with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Metadata is not reachable from the from Metadata.
+//
+//	ids, err := client.Metadata.Path(ctx, a.ID, b.ID, metadata.EdgeChildren)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *MetadataClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{metadata.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Metadata with the to ID is reachable from the Metadata with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *MetadataClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *MetadataClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{metadata.EdgeChildren, metadata.EdgeParent}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case metadata.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(metadata.Table, metadata.FieldID),
+				sqlgraph.To(metadata.Table, metadata.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, metadata.ChildrenTable, metadata.ChildrenColumn),
+			)
+			steps[i] = step
+		case metadata.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(metadata.Table, metadata.FieldID),
+				sqlgraph.To(metadata.Table, metadata.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, metadata.ParentTable, metadata.ParentColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Metadata", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *MetadataClient) Hooks() []Hook {
 	return c.hooks.Metadata
@@ -968,6 +1198,12 @@ func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
 	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Node entities,
+// where each entity is updated by its own NodeUpdateOne builder.
+func (c *NodeClient) UpdateBulk(builders ...*NodeUpdateOne) *NodeUpdateBulk {
+	return &NodeUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Node.
 func (c *NodeClient) Delete() *NodeDelete {
 	mutation := newNodeMutation(c.config, OpDelete)
@@ -1014,13 +1250,18 @@ func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
 func (c *NodeClient) QueryPrev(_m *Node) *NodeQuery {
 	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(node.Table, node.FieldID, id),
 			sqlgraph.To(node.Table, node.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1030,18 +1271,90 @@ func (c *NodeClient) QueryPrev(_m *Node) *NodeQuery {
 func (c *NodeClient) QueryNext(_m *Node) *NodeQuery {
 	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(node.Table, node.FieldID, id),
 			sqlgraph.To(node.Table, node.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the Nodes along the shortest path from the Node with
+// the from ID to the Node with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Node is not reachable from the from Node.
+//
+//	ids, err := client.Node.Path(ctx, a.ID, b.ID, node.EdgePrev)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *NodeClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{node.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Node with the to ID is reachable from the Node with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *NodeClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *NodeClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{node.EdgePrev, node.EdgeNext}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case node.EdgePrev:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+			)
+			steps[i] = step
+		case node.EdgeNext:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Node", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
@@ -1133,6 +1446,12 @@ func (c *PetClient) UpdateOneID(id int) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Pet entities,
+// where each entity is updated by its own PetUpdateOne builder.
+func (c *PetClient) UpdateBulk(builders ...*PetUpdateOne) *PetUpdateBulk {
+	return &PetUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -1179,6 +1498,10 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 func (c *PetClient) QueryOwner(_m *Pet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
@@ -1282,6 +1605,12 @@ func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
 	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Post entities,
+// where each entity is updated by its own PostUpdateOne builder.
+func (c *PostClient) UpdateBulk(builders ...*PostUpdateOne) *PostUpdateBulk {
+	return &PostUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Post.
 func (c *PostClient) Delete() *PostDelete {
 	mutation := newPostMutation(c.config, OpDelete)
@@ -1328,6 +1657,10 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 func (c *PostClient) QueryAuthor(_m *Post) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "author")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(post.Table, post.FieldID, id),
@@ -1431,6 +1764,12 @@ func (c *RentalClient) UpdateOneID(id int) *RentalUpdateOne {
 	return &RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Rental entities,
+// where each entity is updated by its own RentalUpdateOne builder.
+func (c *RentalClient) UpdateBulk(builders ...*RentalUpdateOne) *RentalUpdateBulk {
+	return &RentalUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Rental.
 func (c *RentalClient) Delete() *RentalDelete {
 	mutation := newRentalMutation(c.config, OpDelete)
@@ -1477,6 +1816,10 @@ func (c *RentalClient) GetX(ctx context.Context, id int) *Rental {
 func (c *RentalClient) QueryUser(_m *Rental) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(rental.Table, rental.FieldID, id),
@@ -1493,6 +1836,7 @@ func (c *RentalClient) QueryUser(_m *Rental) *UserQuery {
 func (c *RentalClient) QueryCar(_m *Rental) *CarQuery {
 	query := (&CarClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(rental.Table, rental.FieldID, id),
@@ -1596,6 +1940,12 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of User entities,
+// where each entity is updated by its own UserUpdateOne builder.
+func (c *UserClient) UpdateBulk(builders ...*UserUpdateOne) *UserUpdateBulk {
+	return &UserUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -1642,6 +1992,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 func (c *UserClient) QueryPets(_m *User) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -1658,13 +2009,18 @@ func (c *UserClient) QueryPets(_m *User) *PetQuery {
 func (c *UserClient) QueryParent(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, user.ParentTable, user.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1674,13 +2030,18 @@ func (c *UserClient) QueryParent(_m *User) *UserQuery {
 func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, user.ChildrenTable, user.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1690,13 +2051,18 @@ func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 func (c *UserClient) QuerySpouse(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, user.SpouseTable, user.SpouseColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -1706,6 +2072,7 @@ func (c *UserClient) QuerySpouse(_m *User) *UserQuery {
 func (c *UserClient) QueryCard(_m *User) *CardQuery {
 	query := (&CardClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -1722,6 +2089,10 @@ func (c *UserClient) QueryCard(_m *User) *CardQuery {
 func (c *UserClient) QueryMetadata(_m *User) *MetadataQuery {
 	query := (&MetadataClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "metadata")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -1738,6 +2109,7 @@ func (c *UserClient) QueryMetadata(_m *User) *MetadataQuery {
 func (c *UserClient) QueryInfo(_m *User) *InfoQuery {
 	query := (&InfoClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -1754,6 +2126,7 @@ func (c *UserClient) QueryInfo(_m *User) *InfoQuery {
 func (c *UserClient) QueryRentals(_m *User) *RentalQuery {
 	query := (&RentalClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -1766,6 +2139,80 @@ func (c *UserClient) QueryRentals(_m *User) *RentalQuery {
 	return query
 }
 
+// Path returns the IDs of the Users along the shortest path from the User with
+// the from ID to the User with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to User is not reachable from the from User.
+//
+//	ids, err := client.User.Path(ctx, a.ID, b.ID, user.EdgeParent)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *UserClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{user.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the User with the to ID is reachable from the User with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *UserClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *UserClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{user.EdgeParent, user.EdgeChildren, user.EdgeSpouse}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case user.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, user.ParentTable, user.ParentColumn),
+			)
+			steps[i] = step
+		case user.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.ChildrenTable, user.ChildrenColumn),
+			)
+			steps[i] = step
+		case user.EdgeSpouse:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.SpouseTable, user.SpouseColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of User", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1800,3 +2247,13 @@ type (
 		Car, Card, Info, Metadata, Node, Pet, Post, Rental, User []ent.Interceptor
 	}
 )
+
+// BatchSize sets the maximum number of rows inserted in one statement by
+// the CreateBulk builders. Larger batches are split into chunks that are
+// executed in one transaction, regardless of this option, in case they
+// exceed the placeholder limit of the database dialect.
+func BatchSize(n int) Option {
+	return func(c *config) {
+		c.batchSize = n
+	}
+}
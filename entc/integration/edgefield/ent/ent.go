@@ -284,6 +284,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -624,5 +632,139 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	car.Table: {
+		label: car.Label,
+		fields: map[string]string{
+			car.FieldID:     "id",
+			car.FieldNumber: "number",
+		},
+	},
+	card.Table: {
+		label: card.Label,
+		fields: map[string]string{
+			card.FieldID:      "id",
+			card.FieldNumber:  "number",
+			card.FieldOwnerID: "owner_id",
+		},
+	},
+	info.Table: {
+		label: info.Label,
+		fields: map[string]string{
+			info.FieldID:      "id",
+			info.FieldContent: "content",
+		},
+	},
+	metadata.Table: {
+		label: metadata.Label,
+		fields: map[string]string{
+			metadata.FieldID:       "id",
+			metadata.FieldAge:      "age",
+			metadata.FieldParentID: "parent_id",
+		},
+	},
+	node.Table: {
+		label: node.Label,
+		fields: map[string]string{
+			node.FieldID:     "id",
+			node.FieldValue:  "value",
+			node.FieldPrevID: "prev_id",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID:      "id",
+			pet.FieldOwnerID: "owner_id",
+		},
+	},
+	post.Table: {
+		label: post.Label,
+		fields: map[string]string{
+			post.FieldID:       "id",
+			post.FieldText:     "text",
+			post.FieldAuthorID: "author_id",
+		},
+	},
+	rental.Table: {
+		label: rental.Label,
+		fields: map[string]string{
+			rental.FieldID:     "id",
+			rental.FieldDate:   "date",
+			rental.FieldUserID: "user_id",
+			rental.FieldCarID:  "car_id",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:       "id",
+			user.FieldParentID: "parent_id",
+			user.FieldSpouseID: "spouse_id",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"cards_owner_id_key":       {table: "cards", columns: []string{"owner_id"}},
+	"cards_users_card":         {table: "cards", columns: []string{"owner_id"}},
+	"infos_users_user":         {table: "infos", columns: []string{"id"}},
+	"metadata_metadata_parent": {table: "metadata", columns: []string{"parent_id"}},
+	"metadata_users_metadata":  {table: "metadata", columns: []string{"id"}},
+	"nodes_prev_id_key":        {table: "nodes", columns: []string{"prev_id"}},
+	"nodes_nodes_next":         {table: "nodes", columns: []string{"prev_id"}},
+	"pets_users_pets":          {table: "pets", columns: []string{"owner_id"}},
+	"posts_users_author":       {table: "posts", columns: []string{"author_id"}},
+	"rental_car_id_user_id":    {table: "rentals", columns: []string{"car_id", "user_id"}},
+	"rentals_cars_rentals":     {table: "rentals", columns: []string{"car_id"}},
+	"rentals_users_rentals":    {table: "rentals", columns: []string{"user_id"}},
+	"users_spouse_id_key":      {table: "users", columns: []string{"spouse_id"}},
+	"users_users_children":     {table: "users", columns: []string{"parent_id"}},
+	"users_users_spouse":       {table: "users", columns: []string{"spouse_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
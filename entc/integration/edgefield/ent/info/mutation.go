@@ -8,8 +8,9 @@ package info
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/json/jsontext"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,8 +21,8 @@ import (
 type Mutation struct {
 	op            ent.Op
 	typ           string
-	content       *json.RawMessage
-	appendcontent json.RawMessage
+	content       *jsontext.Value
+	appendcontent jsontext.Value
 	clearedFields map[string]struct{}
 	user          *int
 	cleareduser   bool
@@ -43,13 +44,13 @@ func (m *Mutation) Predicates() []predicate.Info {
 }
 
 // SetContent sets the "content" field.
-func (m *Mutation) SetContent(jm json.RawMessage) {
-	m.content = &jm
+func (m *Mutation) SetContent(j jsontext.Value) {
+	m.content = &j
 	m.appendcontent = nil
 }
 
 // Content returns the value of the "content" field in the mutation.
-func (m *Mutation) Content() (r json.RawMessage, exists bool) {
+func (m *Mutation) Content() (r jsontext.Value, exists bool) {
 	v := m.content
 	if v == nil {
 		return
@@ -57,13 +58,13 @@ func (m *Mutation) Content() (r json.RawMessage, exists bool) {
 	return *v, true
 }
 
-// AppendContent adds jm to the "content" field.
-func (m *Mutation) AppendContent(jm json.RawMessage) {
-	m.appendcontent = append(m.appendcontent, jm...)
+// AppendContent adds j to the "content" field.
+func (m *Mutation) AppendContent(j jsontext.Value) {
+	m.appendcontent = append(m.appendcontent, j...)
 }
 
 // AppendedContent returns the list of values that were appended to the "content" field in this mutation.
-func (m *Mutation) AppendedContent() (json.RawMessage, bool) {
+func (m *Mutation) AppendedContent() (jsontext.Value, bool) {
 	if len(m.appendcontent) == 0 {
 		return nil, false
 	}
@@ -145,6 +146,27 @@ func (m *Mutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. Values of sensitive fields
+// are redacted, and therefore, it is safe to log the mutation.
+func (m *Mutation) String() string {
+	var builder strings.Builder
+	builder.WriteString("InfoMutation(op=")
+	builder.WriteString(m.op.String())
+	for _, name := range m.Fields() {
+		builder.WriteString(", ")
+		builder.WriteString(name)
+		builder.WriteByte('=')
+		v, _ := m.Field(name)
+		builder.WriteString(fmt.Sprintf("%v", v))
+	}
+	for _, name := range m.AddedFields() {
+		v, _ := m.AddedField(name)
+		builder.WriteString(fmt.Sprintf(", %s+=%v", name, v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
@@ -180,7 +202,7 @@ func (m *Mutation) OldField(ctx context.Context, name string) (ent.Value, error)
 func (m *Mutation) SetField(name string, value ent.Value) error {
 	switch name {
 	case FieldContent:
-		v, ok := value.(json.RawMessage)
+		v, ok := value.(jsontext.Value)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
@@ -8,7 +8,7 @@ package ent
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 
@@ -26,7 +26,7 @@ type InfoCreate struct {
 }
 
 // SetContent sets the "content" field.
-func (_c *InfoCreate) SetContent(v json.RawMessage) *InfoCreate {
+func (_c *InfoCreate) SetContent(v jsontext.Value) *InfoCreate {
 	_c.mutation.SetContent(v)
 	return _c
 }
@@ -103,7 +103,7 @@ func (_c *InfoCreate) sqlSave(ctx context.Context) (*Info, error) {
 	_node, _spec := _c.createSpec()
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -181,11 +181,11 @@ func (_c *InfoCreateBulk) Save(ctx context.Context) ([]*Info, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs, BatchSize: _c.batchSize}
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
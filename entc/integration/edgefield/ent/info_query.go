@@ -71,6 +71,9 @@ func (_q *InfoQuery) QueryUser() *UserQuery {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
@@ -302,7 +305,7 @@ func (_q *InfoQuery) WithUser(opts ...func(*UserQuery)) *InfoQuery {
 // Example:
 //
 //	var v []struct {
-//		Content json.RawMessage `json:"content,omitempty"`
+//		Content jsontext.Value `json:"content,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -325,7 +328,7 @@ func (_q *InfoQuery) GroupBy(field string, fields ...string) *InfoGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Content json.RawMessage `json:"content,omitempty"`
+//		Content jsontext.Value `json:"content,omitempty"`
 //	}
 //
 //	client.Info.Query().
@@ -597,6 +600,9 @@ func (_s *InfoSelect) sqlScan(ctx context.Context, root *InfoQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
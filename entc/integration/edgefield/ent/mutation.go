@@ -8,7 +8,7 @@ package ent
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -452,7 +452,7 @@ func (m *InfoMutation) IDs(ctx context.Context) ([]int, error) {
 // OldContent returns the old "content" field's value of the Info entity.
 // If the Info object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InfoMutation) OldContent(ctx context.Context) (v json.RawMessage, err error) {
+func (m *InfoMutation) OldContent(ctx context.Context) (v jsontext.Value, err error) {
 	if !m.Op().Is(OpUpdateOne) {
 		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/edgeschema/ent/migrate"
@@ -121,6 +122,9 @@ type (
 		hooks *hooks
 		// interceptors to execute on queries.
 		inters *inters
+		// batchSize limits the number of rows inserted in one statement by
+		// CreateBulk. Zero means the limit is derived from the dialect.
+		batchSize int
 	}
 	// Option function to configure the client.
 	Option func(*config)
@@ -185,9 +189,12 @@ var ErrTxStarted = errors.New("ent: cannot start a transaction within a transact
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// If the client is already transactional, Tx returns a nested
+// transaction that is backed by a savepoint. See Tx.Savepoint.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
+		return (&Tx{config: c.config}).Savepoint(ctx, "")
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -254,6 +261,115 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts    *sql.TxOptions
+		retries int
+		backoff func(int) time.Duration
+	}
+)
+
+// TxOptions sets the options (e.g. isolation level) that are used to start the transactions.
+func TxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// TxRetries sets the maximum number of times the transaction is retried
+// after a retryable error. The default is 3, and 0 disables retries.
+func TxRetries(n int) TxOption {
+	return func(c *txConfig) {
+		c.retries = n
+	}
+}
+
+// TxBackoff sets the function that returns the duration to wait before
+// the given retry attempt. The default is an exponential backoff that
+// starts at 10ms and is capped at 1s.
+func TxBackoff(f func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = f
+	}
+}
+
+// WithTx runs fn in a transaction. The transaction is committed if fn returns
+// nil, and rolled back if fn returns an error or panics. If the transaction fails
+// with a retryable error (e.g. serialization failure or deadlock), the whole
+// transaction is retried, and therefore, fn must be safe to call more than once.
+//
+// If the client is already transactional, fn runs in a nested transaction that
+// is not retried, and the transaction options are ignored. Retryable errors are
+// expected to be returned to the outermost transaction, which retries as a whole.
+//
+//	err := client.WithTx(ctx, func(tx *Tx) error {
+//		// Use tx.Client() or the tx.<Node> clients.
+//		return nil
+//	}, TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error, opts ...TxOption) error {
+	cfg := txConfig{retries: 3, backoff: txBackoff}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		cfg.opts, cfg.retries = nil, 0
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.withTx(ctx, fn, cfg.opts)
+		if err == nil || attempt >= cfg.retries || !sqlgraph.IsRetryableError(err) {
+			return err
+		}
+		timer := time.NewTimer(cfg.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w: retrying transaction: %v", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// withTx runs fn in one transaction.
+func (c *Client) withTx(ctx context.Context, fn func(tx *Tx) error, opts *sql.TxOptions) error {
+	var (
+		tx  *Tx
+		err error
+	)
+	if opts != nil {
+		tx, err = c.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Tx(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx.
+func txBackoff(attempt int) time.Duration {
+	return min(10*time.Millisecond<<min(attempt, 7), time.Second)
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -408,6 +524,12 @@ func (c *AttachedFileClient) UpdateOneID(id int) *AttachedFileUpdateOne {
 	return &AttachedFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of AttachedFile entities,
+// where each entity is updated by its own AttachedFileUpdateOne builder.
+func (c *AttachedFileClient) UpdateBulk(builders ...*AttachedFileUpdateOne) *AttachedFileUpdateBulk {
+	return &AttachedFileUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for AttachedFile.
 func (c *AttachedFileClient) Delete() *AttachedFileDelete {
 	mutation := newAttachedFileMutation(c.config, OpDelete)
@@ -454,6 +576,7 @@ func (c *AttachedFileClient) GetX(ctx context.Context, id int) *AttachedFile {
 func (c *AttachedFileClient) QueryFi(_m *AttachedFile) *FileQuery {
 	query := (&FileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(attachedfile.Table, attachedfile.FieldID, id),
@@ -470,6 +593,7 @@ func (c *AttachedFileClient) QueryFi(_m *AttachedFile) *FileQuery {
 func (c *AttachedFileClient) QueryProc(_m *AttachedFile) *ProcessQuery {
 	query := (&ProcessClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(attachedfile.Table, attachedfile.FieldID, id),
@@ -573,6 +697,12 @@ func (c *FileClient) UpdateOneID(id int) *FileUpdateOne {
 	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of File entities,
+// where each entity is updated by its own FileUpdateOne builder.
+func (c *FileClient) UpdateBulk(builders ...*FileUpdateOne) *FileUpdateBulk {
+	return &FileUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for File.
 func (c *FileClient) Delete() *FileDelete {
 	mutation := newFileMutation(c.config, OpDelete)
@@ -619,6 +749,7 @@ func (c *FileClient) GetX(ctx context.Context, id int) *File {
 func (c *FileClient) QueryProcesses(_m *File) *ProcessQuery {
 	query := (&ProcessClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(file.Table, file.FieldID, id),
@@ -722,6 +853,12 @@ func (c *FriendshipClient) UpdateOneID(id int) *FriendshipUpdateOne {
 	return &FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Friendship entities,
+// where each entity is updated by its own FriendshipUpdateOne builder.
+func (c *FriendshipClient) UpdateBulk(builders ...*FriendshipUpdateOne) *FriendshipUpdateBulk {
+	return &FriendshipUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Friendship.
 func (c *FriendshipClient) Delete() *FriendshipDelete {
 	mutation := newFriendshipMutation(c.config, OpDelete)
@@ -768,6 +905,10 @@ func (c *FriendshipClient) GetX(ctx context.Context, id int) *Friendship {
 func (c *FriendshipClient) QueryUser(_m *Friendship) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(friendship.Table, friendship.FieldID, id),
@@ -784,6 +925,10 @@ func (c *FriendshipClient) QueryUser(_m *Friendship) *UserQuery {
 func (c *FriendshipClient) QueryFriend(_m *Friendship) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "friend")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(friendship.Table, friendship.FieldID, id),
@@ -887,6 +1032,12 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Group entities,
+// where each entity is updated by its own GroupUpdateOne builder.
+func (c *GroupClient) UpdateBulk(builders ...*GroupUpdateOne) *GroupUpdateBulk {
+	return &GroupUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -933,6 +1084,10 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 func (c *GroupClient) QueryUsers(_m *Group) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "users")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -949,6 +1104,7 @@ func (c *GroupClient) QueryUsers(_m *Group) *UserQuery {
 func (c *GroupClient) QueryTags(_m *Group) *TagQuery {
 	query := (&TagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -965,6 +1121,7 @@ func (c *GroupClient) QueryTags(_m *Group) *TagQuery {
 func (c *GroupClient) QueryJoinedUsers(_m *Group) *UserGroupQuery {
 	query := (&UserGroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -981,6 +1138,7 @@ func (c *GroupClient) QueryJoinedUsers(_m *Group) *UserGroupQuery {
 func (c *GroupClient) QueryGroupTags(_m *Group) *GroupTagQuery {
 	query := (&GroupTagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -1084,6 +1242,12 @@ func (c *GroupTagClient) UpdateOneID(id int) *GroupTagUpdateOne {
 	return &GroupTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of GroupTag entities,
+// where each entity is updated by its own GroupTagUpdateOne builder.
+func (c *GroupTagClient) UpdateBulk(builders ...*GroupTagUpdateOne) *GroupTagUpdateBulk {
+	return &GroupTagUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for GroupTag.
 func (c *GroupTagClient) Delete() *GroupTagDelete {
 	mutation := newGroupTagMutation(c.config, OpDelete)
@@ -1130,6 +1294,7 @@ func (c *GroupTagClient) GetX(ctx context.Context, id int) *GroupTag {
 func (c *GroupTagClient) QueryTag(_m *GroupTag) *TagQuery {
 	query := (&TagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(grouptag.Table, grouptag.FieldID, id),
@@ -1146,6 +1311,7 @@ func (c *GroupTagClient) QueryTag(_m *GroupTag) *TagQuery {
 func (c *GroupTagClient) QueryGroup(_m *GroupTag) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(grouptag.Table, grouptag.FieldID, id),
@@ -1249,6 +1415,12 @@ func (c *ProcessClient) UpdateOneID(id int) *ProcessUpdateOne {
 	return &ProcessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Process entities,
+// where each entity is updated by its own ProcessUpdateOne builder.
+func (c *ProcessClient) UpdateBulk(builders ...*ProcessUpdateOne) *ProcessUpdateBulk {
+	return &ProcessUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Process.
 func (c *ProcessClient) Delete() *ProcessDelete {
 	mutation := newProcessMutation(c.config, OpDelete)
@@ -1295,6 +1467,7 @@ func (c *ProcessClient) GetX(ctx context.Context, id int) *Process {
 func (c *ProcessClient) QueryFiles(_m *Process) *FileQuery {
 	query := (&FileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(process.Table, process.FieldID, id),
@@ -1311,6 +1484,7 @@ func (c *ProcessClient) QueryFiles(_m *Process) *FileQuery {
 func (c *ProcessClient) QueryAttachedFiles(_m *Process) *AttachedFileQuery {
 	query := (&AttachedFileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(process.Table, process.FieldID, id),
@@ -1538,6 +1712,12 @@ func (c *RelationshipInfoClient) UpdateOneID(id int) *RelationshipInfoUpdateOne
 	return &RelationshipInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of RelationshipInfo entities,
+// where each entity is updated by its own RelationshipInfoUpdateOne builder.
+func (c *RelationshipInfoClient) UpdateBulk(builders ...*RelationshipInfoUpdateOne) *RelationshipInfoUpdateBulk {
+	return &RelationshipInfoUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for RelationshipInfo.
 func (c *RelationshipInfoClient) Delete() *RelationshipInfoDelete {
 	mutation := newRelationshipInfoMutation(c.config, OpDelete)
@@ -1671,6 +1851,12 @@ func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
 	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Role entities,
+// where each entity is updated by its own RoleUpdateOne builder.
+func (c *RoleClient) UpdateBulk(builders ...*RoleUpdateOne) *RoleUpdateBulk {
+	return &RoleUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Role.
 func (c *RoleClient) Delete() *RoleDelete {
 	mutation := newRoleMutation(c.config, OpDelete)
@@ -1717,6 +1903,10 @@ func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
 func (c *RoleClient) QueryUser(_m *Role) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(role.Table, role.FieldID, id),
@@ -1733,6 +1923,7 @@ func (c *RoleClient) QueryUser(_m *Role) *UserQuery {
 func (c *RoleClient) QueryRolesUsers(_m *Role) *RoleUserQuery {
 	query := (&RoleUserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(role.Table, role.FieldID, id),
@@ -1952,6 +2143,12 @@ func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
 	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Tag entities,
+// where each entity is updated by its own TagUpdateOne builder.
+func (c *TagClient) UpdateBulk(builders ...*TagUpdateOne) *TagUpdateBulk {
+	return &TagUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Tag.
 func (c *TagClient) Delete() *TagDelete {
 	mutation := newTagMutation(c.config, OpDelete)
@@ -1998,6 +2195,7 @@ func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
 func (c *TagClient) QueryTweets(_m *Tag) *TweetQuery {
 	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tag.Table, tag.FieldID, id),
@@ -2014,6 +2212,7 @@ func (c *TagClient) QueryTweets(_m *Tag) *TweetQuery {
 func (c *TagClient) QueryGroups(_m *Tag) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tag.Table, tag.FieldID, id),
@@ -2030,6 +2229,7 @@ func (c *TagClient) QueryGroups(_m *Tag) *GroupQuery {
 func (c *TagClient) QueryTweetTags(_m *Tag) *TweetTagQuery {
 	query := (&TweetTagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tag.Table, tag.FieldID, id),
@@ -2046,6 +2246,7 @@ func (c *TagClient) QueryTweetTags(_m *Tag) *TweetTagQuery {
 func (c *TagClient) QueryGroupTags(_m *Tag) *GroupTagQuery {
 	query := (&GroupTagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tag.Table, tag.FieldID, id),
@@ -2149,6 +2350,12 @@ func (c *TweetClient) UpdateOneID(id int) *TweetUpdateOne {
 	return &TweetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Tweet entities,
+// where each entity is updated by its own TweetUpdateOne builder.
+func (c *TweetClient) UpdateBulk(builders ...*TweetUpdateOne) *TweetUpdateBulk {
+	return &TweetUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Tweet.
 func (c *TweetClient) Delete() *TweetDelete {
 	mutation := newTweetMutation(c.config, OpDelete)
@@ -2195,6 +2402,10 @@ func (c *TweetClient) GetX(ctx context.Context, id int) *Tweet {
 func (c *TweetClient) QueryLikedUsers(_m *Tweet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "liked_users")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweet.Table, tweet.FieldID, id),
@@ -2211,6 +2422,10 @@ func (c *TweetClient) QueryLikedUsers(_m *Tweet) *UserQuery {
 func (c *TweetClient) QueryUser(_m *Tweet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweet.Table, tweet.FieldID, id),
@@ -2227,6 +2442,7 @@ func (c *TweetClient) QueryUser(_m *Tweet) *UserQuery {
 func (c *TweetClient) QueryTags(_m *Tweet) *TagQuery {
 	query := (&TagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweet.Table, tweet.FieldID, id),
@@ -2243,6 +2459,7 @@ func (c *TweetClient) QueryTags(_m *Tweet) *TagQuery {
 func (c *TweetClient) QueryLikes(_m *Tweet) *TweetLikeQuery {
 	query := (&TweetLikeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweet.Table, tweet.FieldID, id),
@@ -2259,6 +2476,7 @@ func (c *TweetClient) QueryLikes(_m *Tweet) *TweetLikeQuery {
 func (c *TweetClient) QueryTweetUser(_m *Tweet) *UserTweetQuery {
 	query := (&UserTweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweet.Table, tweet.FieldID, id),
@@ -2275,6 +2493,7 @@ func (c *TweetClient) QueryTweetUser(_m *Tweet) *UserTweetQuery {
 func (c *TweetClient) QueryTweetTags(_m *Tweet) *TweetTagQuery {
 	query := (&TweetTagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweet.Table, tweet.FieldID, id),
@@ -2495,6 +2714,12 @@ func (c *TweetTagClient) UpdateOneID(id uuid.UUID) *TweetTagUpdateOne {
 	return &TweetTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of TweetTag entities,
+// where each entity is updated by its own TweetTagUpdateOne builder.
+func (c *TweetTagClient) UpdateBulk(builders ...*TweetTagUpdateOne) *TweetTagUpdateBulk {
+	return &TweetTagUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for TweetTag.
 func (c *TweetTagClient) Delete() *TweetTagDelete {
 	mutation := newTweetTagMutation(c.config, OpDelete)
@@ -2541,6 +2766,7 @@ func (c *TweetTagClient) GetX(ctx context.Context, id uuid.UUID) *TweetTag {
 func (c *TweetTagClient) QueryTag(_m *TweetTag) *TagQuery {
 	query := (&TagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweettag.Table, tweettag.FieldID, id),
@@ -2557,6 +2783,7 @@ func (c *TweetTagClient) QueryTag(_m *TweetTag) *TagQuery {
 func (c *TweetTagClient) QueryTweet(_m *TweetTag) *TweetQuery {
 	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(tweettag.Table, tweettag.FieldID, id),
@@ -2660,6 +2887,12 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of User entities,
+// where each entity is updated by its own UserUpdateOne builder.
+func (c *UserClient) UpdateBulk(builders ...*UserUpdateOne) *UserUpdateBulk {
+	return &UserUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -2706,6 +2939,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2722,13 +2956,18 @@ func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 func (c *UserClient) QueryFriends(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2738,13 +2977,18 @@ func (c *UserClient) QueryFriends(_m *User) *UserQuery {
 func (c *UserClient) QueryRelatives(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, user.RelativesTable, user.RelativesPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2754,6 +2998,7 @@ func (c *UserClient) QueryRelatives(_m *User) *UserQuery {
 func (c *UserClient) QueryLikedTweets(_m *User) *TweetQuery {
 	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2770,6 +3015,7 @@ func (c *UserClient) QueryLikedTweets(_m *User) *TweetQuery {
 func (c *UserClient) QueryTweets(_m *User) *TweetQuery {
 	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2786,6 +3032,7 @@ func (c *UserClient) QueryTweets(_m *User) *TweetQuery {
 func (c *UserClient) QueryRoles(_m *User) *RoleQuery {
 	query := (&RoleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2802,6 +3049,7 @@ func (c *UserClient) QueryRoles(_m *User) *RoleQuery {
 func (c *UserClient) QueryJoinedGroups(_m *User) *UserGroupQuery {
 	query := (&UserGroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2818,6 +3066,7 @@ func (c *UserClient) QueryJoinedGroups(_m *User) *UserGroupQuery {
 func (c *UserClient) QueryFriendships(_m *User) *FriendshipQuery {
 	query := (&FriendshipClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2834,6 +3083,7 @@ func (c *UserClient) QueryFriendships(_m *User) *FriendshipQuery {
 func (c *UserClient) QueryRelationship(_m *User) *RelationshipQuery {
 	query := (&RelationshipClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2850,6 +3100,7 @@ func (c *UserClient) QueryRelationship(_m *User) *RelationshipQuery {
 func (c *UserClient) QueryLikes(_m *User) *TweetLikeQuery {
 	query := (&TweetLikeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2866,6 +3117,7 @@ func (c *UserClient) QueryLikes(_m *User) *TweetLikeQuery {
 func (c *UserClient) QueryUserTweets(_m *User) *UserTweetQuery {
 	query := (&UserTweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2882,6 +3134,7 @@ func (c *UserClient) QueryUserTweets(_m *User) *UserTweetQuery {
 func (c *UserClient) QueryRolesUsers(_m *User) *RoleUserQuery {
 	query := (&RoleUserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -2894,6 +3147,73 @@ func (c *UserClient) QueryRolesUsers(_m *User) *RoleUserQuery {
 	return query
 }
 
+// Path returns the IDs of the Users along the shortest path from the User with
+// the from ID to the User with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to User is not reachable from the from User.
+//
+//	ids, err := client.User.Path(ctx, a.ID, b.ID, user.EdgeFriends)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *UserClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{user.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the User with the to ID is reachable from the User with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *UserClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *UserClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{user.EdgeFriends, user.EdgeRelatives}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case user.EdgeFriends:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+			)
+			steps[i] = step
+		case user.EdgeRelatives:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.RelativesTable, user.RelativesPrimaryKey...),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of User", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -2986,6 +3306,12 @@ func (c *UserGroupClient) UpdateOneID(id int) *UserGroupUpdateOne {
 	return &UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of UserGroup entities,
+// where each entity is updated by its own UserGroupUpdateOne builder.
+func (c *UserGroupClient) UpdateBulk(builders ...*UserGroupUpdateOne) *UserGroupUpdateBulk {
+	return &UserGroupUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for UserGroup.
 func (c *UserGroupClient) Delete() *UserGroupDelete {
 	mutation := newUserGroupMutation(c.config, OpDelete)
@@ -3032,6 +3358,10 @@ func (c *UserGroupClient) GetX(ctx context.Context, id int) *UserGroup {
 func (c *UserGroupClient) QueryUser(_m *UserGroup) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(usergroup.Table, usergroup.FieldID, id),
@@ -3048,6 +3378,7 @@ func (c *UserGroupClient) QueryUser(_m *UserGroup) *UserQuery {
 func (c *UserGroupClient) QueryGroup(_m *UserGroup) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(usergroup.Table, usergroup.FieldID, id),
@@ -3151,6 +3482,12 @@ func (c *UserTweetClient) UpdateOneID(id int) *UserTweetUpdateOne {
 	return &UserTweetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of UserTweet entities,
+// where each entity is updated by its own UserTweetUpdateOne builder.
+func (c *UserTweetClient) UpdateBulk(builders ...*UserTweetUpdateOne) *UserTweetUpdateBulk {
+	return &UserTweetUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for UserTweet.
 func (c *UserTweetClient) Delete() *UserTweetDelete {
 	mutation := newUserTweetMutation(c.config, OpDelete)
@@ -3197,6 +3534,10 @@ func (c *UserTweetClient) GetX(ctx context.Context, id int) *UserTweet {
 func (c *UserTweetClient) QueryUser(_m *UserTweet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "user")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(usertweet.Table, usertweet.FieldID, id),
@@ -3213,6 +3554,7 @@ func (c *UserTweetClient) QueryUser(_m *UserTweet) *UserQuery {
 func (c *UserTweetClient) QueryTweet(_m *UserTweet) *TweetQuery {
 	query := (&TweetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(usertweet.Table, usertweet.FieldID, id),
@@ -3263,3 +3605,13 @@ type (
 		UserGroup, UserTweet []ent.Interceptor
 	}
 )
+
+// BatchSize sets the maximum number of rows inserted in one statement by
+// the CreateBulk builders. Larger batches are split into chunks that are
+// executed in one transaction, regardless of this option, in case they
+// exceed the placeholder limit of the database dialect.
+func BatchSize(n int) Option {
+	return func(c *config) {
+		c.batchSize = n
+	}
+}
@@ -300,6 +300,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -640,5 +648,217 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	attachedfile.Table: {
+		label: attachedfile.Label,
+		fields: map[string]string{
+			attachedfile.FieldID:         "id",
+			attachedfile.FieldAttachTime: "attach_time",
+			attachedfile.FieldFID:        "f_id",
+			attachedfile.FieldProcID:     "proc_id",
+		},
+	},
+	file.Table: {
+		label: file.Label,
+		fields: map[string]string{
+			file.FieldID:   "id",
+			file.FieldName: "name",
+		},
+	},
+	friendship.Table: {
+		label: friendship.Label,
+		fields: map[string]string{
+			friendship.FieldID:        "id",
+			friendship.FieldWeight:    "weight",
+			friendship.FieldCreatedAt: "created_at",
+			friendship.FieldUserID:    "user_id",
+			friendship.FieldFriendID:  "friend_id",
+		},
+	},
+	group.Table: {
+		label: group.Label,
+		fields: map[string]string{
+			group.FieldID:   "id",
+			group.FieldName: "name",
+		},
+	},
+	grouptag.Table: {
+		label: grouptag.Label,
+		fields: map[string]string{
+			grouptag.FieldID:      "id",
+			grouptag.FieldTagID:   "tag_id",
+			grouptag.FieldGroupID: "group_id",
+		},
+	},
+	process.Table: {
+		label: process.Label,
+		fields: map[string]string{
+			process.FieldID: "id",
+		},
+	},
+	relationship.Table: {
+		label: relationship.Label,
+		fields: map[string]string{
+			relationship.FieldWeight:     "weight",
+			relationship.FieldUserID:     "user_id",
+			relationship.FieldRelativeID: "relative_id",
+			relationship.FieldInfoID:     "info_id",
+		},
+	},
+	relationshipinfo.Table: {
+		label: relationshipinfo.Label,
+		fields: map[string]string{
+			relationshipinfo.FieldID:   "id",
+			relationshipinfo.FieldText: "text",
+		},
+	},
+	role.Table: {
+		label: role.Label,
+		fields: map[string]string{
+			role.FieldID:        "id",
+			role.FieldName:      "name",
+			role.FieldCreatedAt: "created_at",
+		},
+	},
+	roleuser.Table: {
+		label: roleuser.Label,
+		fields: map[string]string{
+			roleuser.FieldCreatedAt: "created_at",
+			roleuser.FieldRoleID:    "role_id",
+			roleuser.FieldUserID:    "user_id",
+		},
+	},
+	tag.Table: {
+		label: tag.Label,
+		fields: map[string]string{
+			tag.FieldID:    "id",
+			tag.FieldValue: "value",
+		},
+	},
+	tweet.Table: {
+		label: tweet.Label,
+		fields: map[string]string{
+			tweet.FieldID:   "id",
+			tweet.FieldText: "text",
+		},
+	},
+	tweetlike.Table: {
+		label: tweetlike.Label,
+		fields: map[string]string{
+			tweetlike.FieldLikedAt: "liked_at",
+			tweetlike.FieldUserID:  "user_id",
+			tweetlike.FieldTweetID: "tweet_id",
+		},
+	},
+	tweettag.Table: {
+		label: tweettag.Label,
+		fields: map[string]string{
+			tweettag.FieldID:      "id",
+			tweettag.FieldAddedAt: "added_at",
+			tweettag.FieldTagID:   "tag_id",
+			tweettag.FieldTweetID: "tweet_id",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:   "id",
+			user.FieldName: "name",
+		},
+	},
+	usergroup.Table: {
+		label: usergroup.Label,
+		fields: map[string]string{
+			usergroup.FieldID:       "id",
+			usergroup.FieldJoinedAt: "joined_at",
+			usergroup.FieldUserID:   "user_id",
+			usergroup.FieldGroupID:  "group_id",
+		},
+	},
+	usertweet.Table: {
+		label: usertweet.Label,
+		fields: map[string]string{
+			usertweet.FieldID:        "id",
+			usertweet.FieldCreatedAt: "created_at",
+			usertweet.FieldUserID:    "user_id",
+			usertweet.FieldTweetID:   "tweet_id",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"attachedfile_proc_id_f_id":             {table: "attached_files", columns: []string{"proc_id", "f_id"}},
+	"attached_files_files_fi":               {table: "attached_files", columns: []string{"f_id"}},
+	"attached_files_processes_proc":         {table: "attached_files", columns: []string{"proc_id"}},
+	"friendships_edge":                      {table: "friendships", columns: []string{"user_id", "friend_id"}},
+	"friendships_users_user":                {table: "friendships", columns: []string{"user_id"}},
+	"friendships_users_friend":              {table: "friendships", columns: []string{"friend_id"}},
+	"grouptag_tag_id_group_id":              {table: "group_tags", columns: []string{"tag_id", "group_id"}},
+	"group_tags_tags_tag":                   {table: "group_tags", columns: []string{"tag_id"}},
+	"group_tags_groups_group":               {table: "group_tags", columns: []string{"group_id"}},
+	"relationship_info_id":                  {table: "relationships", columns: []string{"info_id"}},
+	"relationships_users_user":              {table: "relationships", columns: []string{"user_id"}},
+	"relationships_users_relative":          {table: "relationships", columns: []string{"relative_id"}},
+	"relationships_relationship_infos_info": {table: "relationships", columns: []string{"info_id"}},
+	"roles_name_key":                        {table: "roles", columns: []string{"name"}},
+	"role_users_roles_role":                 {table: "role_users", columns: []string{"role_id"}},
+	"role_users_users_user":                 {table: "role_users", columns: []string{"user_id"}},
+	"tweet_likes_tweets_tweet":              {table: "tweet_likes", columns: []string{"tweet_id"}},
+	"tweet_likes_users_user":                {table: "tweet_likes", columns: []string{"user_id"}},
+	"tweettag_tag_id_tweet_id":              {table: "tweet_tags", columns: []string{"tag_id", "tweet_id"}},
+	"tweet_tags_tags_tag":                   {table: "tweet_tags", columns: []string{"tag_id"}},
+	"tweet_tags_tweets_tweet":               {table: "tweet_tags", columns: []string{"tweet_id"}},
+	"usergroup_user_id_group_id":            {table: "user_groups", columns: []string{"user_id", "group_id"}},
+	"user_groups_users_user":                {table: "user_groups", columns: []string{"user_id"}},
+	"user_groups_groups_group":              {table: "user_groups", columns: []string{"group_id"}},
+	"usertweet_tweet_id":                    {table: "user_tweets", columns: []string{"tweet_id"}},
+	"usertweet_user_id_tweet_id":            {table: "user_tweets", columns: []string{"user_id", "tweet_id"}},
+	"user_tweets_users_user":                {table: "user_tweets", columns: []string{"user_id"}},
+	"user_tweets_tweets_tweet":              {table: "user_tweets", columns: []string{"tweet_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
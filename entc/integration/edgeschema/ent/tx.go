@@ -8,9 +8,11 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 )
 
 // Tx is a transactional client that is created by calling Client.Tx().
@@ -180,6 +182,101 @@ func (tx *Tx) Client() *Client {
 	return tx.client
 }
 
+// Tx returns a nested transactional client that is backed by a savepoint
+// of the transaction. See Tx.Savepoint for more information.
+func (tx *Tx) Tx(ctx context.Context) (*Tx, error) {
+	return tx.Savepoint(ctx, "")
+}
+
+// Savepoint creates a savepoint with the given name in the transaction, and returns a nested
+// transactional client that is backed by it. Committing the nested transaction releases the
+// savepoint, and rolling it back discards the changes that were made after the savepoint was
+// created, without affecting the parent transaction. If the name is empty, a unique name is
+// generated.
+//
+// Note that hooks that were registered on the nested transaction are executed when it is
+// committed or rolled back, and not when the parent transaction completes.
+func (tx *Tx) Savepoint(ctx context.Context, name string) (*Tx, error) {
+	txd := tx.config.driver.(*txDriver)
+	if name == "" {
+		prefix := txd.savepoint
+		if prefix == "" {
+			prefix = "ent_sp"
+		}
+		txd.mu.Lock()
+		txd.savepoints++
+		name = fmt.Sprintf("%s_%d", prefix, txd.savepoints)
+		txd.mu.Unlock()
+	}
+	sp := &savepointTx{ctx: ctx, drv: txd, name: name}
+	if err := sp.exec("SAVEPOINT "); err != nil {
+		return nil, fmt.Errorf("ent: creating savepoint %q: %w", name, err)
+	}
+	cfg := tx.config
+	cfg.driver = &txDriver{tx: sp, drv: txd.drv, savepoint: name}
+	return &Tx{
+		ctx:              ctx,
+		config:           cfg,
+		AttachedFile:     NewAttachedFileClient(cfg),
+		File:             NewFileClient(cfg),
+		Friendship:       NewFriendshipClient(cfg),
+		Group:            NewGroupClient(cfg),
+		GroupTag:         NewGroupTagClient(cfg),
+		Process:          NewProcessClient(cfg),
+		Relationship:     NewRelationshipClient(cfg),
+		RelationshipInfo: NewRelationshipInfoClient(cfg),
+		Role:             NewRoleClient(cfg),
+		RoleUser:         NewRoleUserClient(cfg),
+		Tag:              NewTagClient(cfg),
+		Tweet:            NewTweetClient(cfg),
+		TweetLike:        NewTweetLikeClient(cfg),
+		TweetTag:         NewTweetTagClient(cfg),
+		User:             NewUserClient(cfg),
+		UserGroup:        NewUserGroupClient(cfg),
+		UserTweet:        NewUserTweetClient(cfg),
+	}, nil
+}
+
+// savepointTx implements the dialect.Tx interface for nested transactions. Statements
+// are executed by the parent transaction, and Commit and Rollback release and roll back
+// to the savepoint.
+type savepointTx struct {
+	ctx  context.Context
+	drv  *txDriver
+	name string
+}
+
+// Exec calls the Exec of the parent transaction.
+func (tx *savepointTx) Exec(ctx context.Context, query string, args, v any) error {
+	return tx.drv.Exec(ctx, query, args, v)
+}
+
+// Query calls the Query of the parent transaction.
+func (tx *savepointTx) Query(ctx context.Context, query string, args, v any) error {
+	return tx.drv.Query(ctx, query, args, v)
+}
+
+// Commit releases the savepoint.
+func (tx *savepointTx) Commit() error {
+	return tx.exec("RELEASE SAVEPOINT ")
+}
+
+// Rollback rolls back to the savepoint, and releases it.
+func (tx *savepointTx) Rollback() error {
+	if err := tx.exec("ROLLBACK TO SAVEPOINT "); err != nil {
+		return err
+	}
+	return tx.exec("RELEASE SAVEPOINT ")
+}
+
+// exec executes the given savepoint statement on the parent transaction.
+func (tx *savepointTx) exec(stmt string) error {
+	query := sql.Dialect(tx.drv.Dialect()).String(func(b *sql.Builder) {
+		b.WriteString(stmt).Ident(tx.name)
+	})
+	return tx.drv.Exec(tx.ctx, query, []any{}, nil)
+}
+
 func (tx *Tx) init() {
 	tx.AttachedFile = NewAttachedFileClient(tx.config)
 	tx.File = NewFileClient(tx.config)
@@ -220,6 +317,10 @@ type txDriver struct {
 	mu         sync.Mutex
 	onCommit   []CommitHook
 	onRollback []RollbackHook
+	// savepoint holds the name of the savepoint that backs the
+	// transaction, and the number of savepoints created in it.
+	savepoint  string
+	savepoints int
 }
 
 // newTx creates a new transactional driver.
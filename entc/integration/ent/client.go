@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/ent/migrate"
@@ -131,6 +132,9 @@ type (
 		hooks *hooks
 		// interceptors to execute on queries.
 		inters *inters
+		// batchSize limits the number of rows inserted in one statement by
+		// CreateBulk. Zero means the limit is derived from the dialect.
+		batchSize int
 	}
 	// Option function to configure the client.
 	Option func(*config)
@@ -195,9 +199,12 @@ var ErrTxStarted = errors.New("ent: cannot start a transaction within a transact
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// If the client is already transactional, Tx returns a nested
+// transaction that is backed by a savepoint. See Tx.Savepoint.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
+		return (&Tx{config: c.config}).Savepoint(ctx, "")
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -268,6 +275,115 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts    *sql.TxOptions
+		retries int
+		backoff func(int) time.Duration
+	}
+)
+
+// TxOptions sets the options (e.g. isolation level) that are used to start the transactions.
+func TxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// TxRetries sets the maximum number of times the transaction is retried
+// after a retryable error. The default is 3, and 0 disables retries.
+func TxRetries(n int) TxOption {
+	return func(c *txConfig) {
+		c.retries = n
+	}
+}
+
+// TxBackoff sets the function that returns the duration to wait before
+// the given retry attempt. The default is an exponential backoff that
+// starts at 10ms and is capped at 1s.
+func TxBackoff(f func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = f
+	}
+}
+
+// WithTx runs fn in a transaction. The transaction is committed if fn returns
+// nil, and rolled back if fn returns an error or panics. If the transaction fails
+// with a retryable error (e.g. serialization failure or deadlock), the whole
+// transaction is retried, and therefore, fn must be safe to call more than once.
+//
+// If the client is already transactional, fn runs in a nested transaction that
+// is not retried, and the transaction options are ignored. Retryable errors are
+// expected to be returned to the outermost transaction, which retries as a whole.
+//
+//	err := client.WithTx(ctx, func(tx *Tx) error {
+//		// Use tx.Client() or the tx.<Node> clients.
+//		return nil
+//	}, TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error, opts ...TxOption) error {
+	cfg := txConfig{retries: 3, backoff: txBackoff}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		cfg.opts, cfg.retries = nil, 0
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.withTx(ctx, fn, cfg.opts)
+		if err == nil || attempt >= cfg.retries || !sqlgraph.IsRetryableError(err) {
+			return err
+		}
+		timer := time.NewTimer(cfg.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w: retrying transaction: %v", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// withTx runs fn in one transaction.
+func (c *Client) withTx(ctx context.Context, fn func(tx *Tx) error, opts *sql.TxOptions) error {
+	var (
+		tx  *Tx
+		err error
+	)
+	if opts != nil {
+		tx, err = c.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Tx(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx.
+func txBackoff(attempt int) time.Duration {
+	return min(10*time.Millisecond<<min(attempt, 7), time.Second)
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -436,6 +552,12 @@ func (c *APIClient) UpdateOneID(id int) *APIUpdateOne {
 	return &APIUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Api entities,
+// where each entity is updated by its own APIUpdateOne builder.
+func (c *APIClient) UpdateBulk(builders ...*APIUpdateOne) *APIUpdateBulk {
+	return &APIUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Api.
 func (c *APIClient) Delete() *APIDelete {
 	mutation := newAPIMutation(c.config, OpDelete)
@@ -569,6 +691,12 @@ func (c *BuilderClient) UpdateOneID(id int) *BuilderUpdateOne {
 	return &BuilderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Builder entities,
+// where each entity is updated by its own BuilderUpdateOne builder.
+func (c *BuilderClient) UpdateBulk(builders ...*BuilderUpdateOne) *BuilderUpdateBulk {
+	return &BuilderUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Builder.
 func (c *BuilderClient) Delete() *BuilderDelete {
 	mutation := newBuilderMutation(c.config, OpDelete)
@@ -702,6 +830,12 @@ func (c *CardClient) UpdateOneID(id int) *CardUpdateOne {
 	return &CardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Card entities,
+// where each entity is updated by its own CardUpdateOne builder.
+func (c *CardClient) UpdateBulk(builders ...*CardUpdateOne) *CardUpdateBulk {
+	return &CardUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Card.
 func (c *CardClient) Delete() *CardDelete {
 	mutation := newCardMutation(c.config, OpDelete)
@@ -748,6 +882,10 @@ func (c *CardClient) GetX(ctx context.Context, id int) *Card {
 func (c *CardClient) QueryOwner(_m *Card) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(card.Table, card.FieldID, id),
@@ -764,6 +902,7 @@ func (c *CardClient) QueryOwner(_m *Card) *UserQuery {
 func (c *CardClient) QuerySpec(_m *Card) *SpecQuery {
 	query := (&SpecClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(card.Table, card.FieldID, id),
@@ -867,6 +1006,12 @@ func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
 	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Comment entities,
+// where each entity is updated by its own CommentUpdateOne builder.
+func (c *CommentClient) UpdateBulk(builders ...*CommentUpdateOne) *CommentUpdateBulk {
+	return &CommentUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Comment.
 func (c *CommentClient) Delete() *CommentDelete {
 	mutation := newCommentMutation(c.config, OpDelete)
@@ -1000,6 +1145,12 @@ func (c *ExValueScanClient) UpdateOneID(id int) *ExValueScanUpdateOne {
 	return &ExValueScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of ExValueScan entities,
+// where each entity is updated by its own ExValueScanUpdateOne builder.
+func (c *ExValueScanClient) UpdateBulk(builders ...*ExValueScanUpdateOne) *ExValueScanUpdateBulk {
+	return &ExValueScanUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for ExValueScan.
 func (c *ExValueScanClient) Delete() *ExValueScanDelete {
 	mutation := newExValueScanMutation(c.config, OpDelete)
@@ -1133,6 +1284,12 @@ func (c *FieldTypeClient) UpdateOneID(id int) *FieldTypeUpdateOne {
 	return &FieldTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of FieldType entities,
+// where each entity is updated by its own FieldTypeUpdateOne builder.
+func (c *FieldTypeClient) UpdateBulk(builders ...*FieldTypeUpdateOne) *FieldTypeUpdateBulk {
+	return &FieldTypeUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for FieldType.
 func (c *FieldTypeClient) Delete() *FieldTypeDelete {
 	mutation := newFieldTypeMutation(c.config, OpDelete)
@@ -1266,6 +1423,12 @@ func (c *FileClient) UpdateOneID(id int) *FileUpdateOne {
 	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of File entities,
+// where each entity is updated by its own FileUpdateOne builder.
+func (c *FileClient) UpdateBulk(builders ...*FileUpdateOne) *FileUpdateBulk {
+	return &FileUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for File.
 func (c *FileClient) Delete() *FileDelete {
 	mutation := newFileMutation(c.config, OpDelete)
@@ -1312,6 +1475,10 @@ func (c *FileClient) GetX(ctx context.Context, id int) *File {
 func (c *FileClient) QueryOwner(_m *File) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(file.Table, file.FieldID, id),
@@ -1328,6 +1495,7 @@ func (c *FileClient) QueryOwner(_m *File) *UserQuery {
 func (c *FileClient) QueryType(_m *File) *FileTypeQuery {
 	query := (&FileTypeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(file.Table, file.FieldID, id),
@@ -1344,6 +1512,7 @@ func (c *FileClient) QueryType(_m *File) *FileTypeQuery {
 func (c *FileClient) QueryField(_m *File) *FieldTypeQuery {
 	query := (&FieldTypeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(file.Table, file.FieldID, id),
@@ -1447,6 +1616,12 @@ func (c *FileTypeClient) UpdateOneID(id int) *FileTypeUpdateOne {
 	return &FileTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of FileType entities,
+// where each entity is updated by its own FileTypeUpdateOne builder.
+func (c *FileTypeClient) UpdateBulk(builders ...*FileTypeUpdateOne) *FileTypeUpdateBulk {
+	return &FileTypeUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for FileType.
 func (c *FileTypeClient) Delete() *FileTypeDelete {
 	mutation := newFileTypeMutation(c.config, OpDelete)
@@ -1493,6 +1668,7 @@ func (c *FileTypeClient) GetX(ctx context.Context, id int) *FileType {
 func (c *FileTypeClient) QueryFiles(_m *FileType) *FileQuery {
 	query := (&FileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(filetype.Table, filetype.FieldID, id),
@@ -1596,6 +1772,12 @@ func (c *GoodsClient) UpdateOneID(id int) *GoodsUpdateOne {
 	return &GoodsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Goods entities,
+// where each entity is updated by its own GoodsUpdateOne builder.
+func (c *GoodsClient) UpdateBulk(builders ...*GoodsUpdateOne) *GoodsUpdateBulk {
+	return &GoodsUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Goods.
 func (c *GoodsClient) Delete() *GoodsDelete {
 	mutation := newGoodsMutation(c.config, OpDelete)
@@ -1729,6 +1911,12 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Group entities,
+// where each entity is updated by its own GroupUpdateOne builder.
+func (c *GroupClient) UpdateBulk(builders ...*GroupUpdateOne) *GroupUpdateBulk {
+	return &GroupUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -1775,6 +1963,7 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 func (c *GroupClient) QueryFiles(_m *Group) *FileQuery {
 	query := (&FileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -1791,6 +1980,10 @@ func (c *GroupClient) QueryFiles(_m *Group) *FileQuery {
 func (c *GroupClient) QueryBlocked(_m *Group) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "blocked")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -1807,6 +2000,10 @@ func (c *GroupClient) QueryBlocked(_m *Group) *UserQuery {
 func (c *GroupClient) QueryUsers(_m *Group) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "users")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -1823,6 +2020,7 @@ func (c *GroupClient) QueryUsers(_m *Group) *UserQuery {
 func (c *GroupClient) QueryInfo(_m *Group) *GroupInfoQuery {
 	query := (&GroupInfoClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(group.Table, group.FieldID, id),
@@ -1926,6 +2124,12 @@ func (c *GroupInfoClient) UpdateOneID(id int) *GroupInfoUpdateOne {
 	return &GroupInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of GroupInfo entities,
+// where each entity is updated by its own GroupInfoUpdateOne builder.
+func (c *GroupInfoClient) UpdateBulk(builders ...*GroupInfoUpdateOne) *GroupInfoUpdateBulk {
+	return &GroupInfoUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for GroupInfo.
 func (c *GroupInfoClient) Delete() *GroupInfoDelete {
 	mutation := newGroupInfoMutation(c.config, OpDelete)
@@ -1972,6 +2176,7 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id int) *GroupInfo {
 func (c *GroupInfoClient) QueryGroups(_m *GroupInfo) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(groupinfo.Table, groupinfo.FieldID, id),
@@ -2075,6 +2280,12 @@ func (c *ItemClient) UpdateOneID(id string) *ItemUpdateOne {
 	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Item entities,
+// where each entity is updated by its own ItemUpdateOne builder.
+func (c *ItemClient) UpdateBulk(builders ...*ItemUpdateOne) *ItemUpdateBulk {
+	return &ItemUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Item.
 func (c *ItemClient) Delete() *ItemDelete {
 	mutation := newItemMutation(c.config, OpDelete)
@@ -2208,6 +2419,12 @@ func (c *LicenseClient) UpdateOneID(id int) *LicenseUpdateOne {
 	return &LicenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of License entities,
+// where each entity is updated by its own LicenseUpdateOne builder.
+func (c *LicenseClient) UpdateBulk(builders ...*LicenseUpdateOne) *LicenseUpdateBulk {
+	return &LicenseUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for License.
 func (c *LicenseClient) Delete() *LicenseDelete {
 	mutation := newLicenseMutation(c.config, OpDelete)
@@ -2341,6 +2558,12 @@ func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
 	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Node entities,
+// where each entity is updated by its own NodeUpdateOne builder.
+func (c *NodeClient) UpdateBulk(builders ...*NodeUpdateOne) *NodeUpdateBulk {
+	return &NodeUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Node.
 func (c *NodeClient) Delete() *NodeDelete {
 	mutation := newNodeMutation(c.config, OpDelete)
@@ -2387,13 +2610,18 @@ func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
 func (c *NodeClient) QueryPrev(_m *Node) *NodeQuery {
 	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(node.Table, node.FieldID, id),
 			sqlgraph.To(node.Table, node.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -2403,18 +2631,90 @@ func (c *NodeClient) QueryPrev(_m *Node) *NodeQuery {
 func (c *NodeClient) QueryNext(_m *Node) *NodeQuery {
 	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(node.Table, node.FieldID, id),
 			sqlgraph.To(node.Table, node.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the Nodes along the shortest path from the Node with
+// the from ID to the Node with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Node is not reachable from the from Node.
+//
+//	ids, err := client.Node.Path(ctx, a.ID, b.ID, node.EdgePrev)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *NodeClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{node.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Node with the to ID is reachable from the Node with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *NodeClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *NodeClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{node.EdgePrev, node.EdgeNext}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case node.EdgePrev:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+			)
+			steps[i] = step
+		case node.EdgeNext:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Node", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
@@ -2506,6 +2806,12 @@ func (c *PCClient) UpdateOneID(id int) *PCUpdateOne {
 	return &PCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of PC entities,
+// where each entity is updated by its own PCUpdateOne builder.
+func (c *PCClient) UpdateBulk(builders ...*PCUpdateOne) *PCUpdateBulk {
+	return &PCUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for PC.
 func (c *PCClient) Delete() *PCDelete {
 	mutation := newPCMutation(c.config, OpDelete)
@@ -2639,6 +2945,12 @@ func (c *PetClient) UpdateOneID(id int) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Pet entities,
+// where each entity is updated by its own PetUpdateOne builder.
+func (c *PetClient) UpdateBulk(builders ...*PetUpdateOne) *PetUpdateBulk {
+	return &PetUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -2685,6 +2997,10 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 func (c *PetClient) QueryTeam(_m *Pet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "team")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
@@ -2701,6 +3017,10 @@ func (c *PetClient) QueryTeam(_m *Pet) *UserQuery {
 func (c *PetClient) QueryOwner(_m *Pet) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
+		if query.recursive != nil {
+			return nil, fmt.Errorf("ent: edge %q cannot be traversed recursively", "owner")
+		}
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pet.Table, pet.FieldID, id),
@@ -2804,6 +3124,12 @@ func (c *SpecClient) UpdateOneID(id int) *SpecUpdateOne {
 	return &SpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Spec entities,
+// where each entity is updated by its own SpecUpdateOne builder.
+func (c *SpecClient) UpdateBulk(builders ...*SpecUpdateOne) *SpecUpdateBulk {
+	return &SpecUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Spec.
 func (c *SpecClient) Delete() *SpecDelete {
 	mutation := newSpecMutation(c.config, OpDelete)
@@ -2850,6 +3176,7 @@ func (c *SpecClient) GetX(ctx context.Context, id int) *Spec {
 func (c *SpecClient) QueryCard(_m *Spec) *CardQuery {
 	query := (&CardClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(spec.Table, spec.FieldID, id),
@@ -2953,6 +3280,12 @@ func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
 	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of Task entities,
+// where each entity is updated by its own TaskUpdateOne builder.
+func (c *TaskClient) UpdateBulk(builders ...*TaskUpdateOne) *TaskUpdateBulk {
+	return &TaskUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Task.
 func (c *TaskClient) Delete() *TaskDelete {
 	mutation := newTaskMutation(c.config, OpDelete)
@@ -3086,6 +3419,12 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateBulk returns a builder for updating a bulk of User entities,
+// where each entity is updated by its own UserUpdateOne builder.
+func (c *UserClient) UpdateBulk(builders ...*UserUpdateOne) *UserUpdateBulk {
+	return &UserUpdateBulk{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -3132,6 +3471,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 func (c *UserClient) QueryCard(_m *User) *CardQuery {
 	query := (&CardClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -3148,6 +3488,7 @@ func (c *UserClient) QueryCard(_m *User) *CardQuery {
 func (c *UserClient) QueryPets(_m *User) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -3164,6 +3505,7 @@ func (c *UserClient) QueryPets(_m *User) *PetQuery {
 func (c *UserClient) QueryFiles(_m *User) *FileQuery {
 	query := (&FileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -3180,6 +3522,7 @@ func (c *UserClient) QueryFiles(_m *User) *FileQuery {
 func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -3196,13 +3539,18 @@ func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 func (c *UserClient) QueryFriends(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -3212,13 +3560,18 @@ func (c *UserClient) QueryFriends(_m *User) *UserQuery {
 func (c *UserClient) QueryFollowers(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, true, user.FollowersTable, user.FollowersPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -3228,13 +3581,18 @@ func (c *UserClient) QueryFollowers(_m *User) *UserQuery {
 func (c *UserClient) QueryFollowing(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2M, false, user.FollowingTable, user.FollowingPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -3244,6 +3602,7 @@ func (c *UserClient) QueryFollowing(_m *User) *UserQuery {
 func (c *UserClient) QueryTeam(_m *User) *PetQuery {
 	query := (&PetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
@@ -3260,13 +3619,18 @@ func (c *UserClient) QueryTeam(_m *User) *PetQuery {
 func (c *UserClient) QuerySpouse(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.O2O, false, user.SpouseTable, user.SpouseColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -3276,13 +3640,18 @@ func (c *UserClient) QuerySpouse(_m *User) *UserQuery {
 func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, true, user.ChildrenTable, user.ChildrenColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
@@ -3292,18 +3661,118 @@ func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 func (c *UserClient) QueryParent(_m *User) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, false, user.ParentTable, user.ParentColumn),
 		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		if query.recursive != nil {
+			fromV = sqlgraph.RecursiveNeighbors(_m.driver.Dialect(), step, query.recursive)
+		} else {
+			fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		}
 		return fromV, nil
 	}
 	return query
 }
 
+// Path returns the IDs of the Users along the shortest path from the User with
+// the from ID to the User with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to User is not reachable from the from User.
+//
+//	ids, err := client.User.Path(ctx, a.ID, b.ID, user.EdgeFriends)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *UserClient) Path(ctx context.Context, from, to int, edges ...string) ([]int, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqlgraph.ShortestPath(ctx, c.driver, from, to, steps...)
+	if err != nil {
+		var nf *sqlgraph.NotFoundError
+		if errors.As(err, &nf) {
+			return nil, &NotFoundError{user.Label}
+		}
+		return nil, err
+	}
+	ids := make([]int, len(path))
+	for i := range path {
+		ids[i] = path[i].(int)
+	}
+	return ids, nil
+}
+
+// Reachable reports if the User with the to ID is reachable from the User with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *UserClient) Reachable(ctx context.Context, from, to int, edges ...string) (bool, error) {
+	steps, err := c.pathSteps(edges)
+	if err != nil {
+		return false, err
+	}
+	return sqlgraph.Reachable(ctx, c.driver, from, to, steps...)
+}
+
+// pathSteps returns the graph steps of the given edge names for path queries.
+func (c *UserClient) pathSteps(edges []string) ([]*sqlgraph.Step, error) {
+	if len(edges) == 0 {
+		edges = []string{user.EdgeFriends, user.EdgeFollowers, user.EdgeFollowing, user.EdgeSpouse, user.EdgeChildren, user.EdgeParent}
+	}
+	steps := make([]*sqlgraph.Step, len(edges))
+	for i, e := range edges {
+		switch e {
+		case user.EdgeFriends:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+			)
+			steps[i] = step
+		case user.EdgeFollowers:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, user.FollowersTable, user.FollowersPrimaryKey...),
+			)
+			steps[i] = step
+		case user.EdgeFollowing:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FollowingTable, user.FollowingPrimaryKey...),
+			)
+			steps[i] = step
+		case user.EdgeSpouse:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.SpouseTable, user.SpouseColumn),
+			)
+			steps[i] = step
+		case user.EdgeChildren:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.ChildrenTable, user.ChildrenColumn),
+			)
+			steps[i] = step
+		case user.EdgeParent:
+			step := sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, user.ParentTable, user.ParentColumn),
+			)
+			steps[i] = step
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of User", e)
+		}
+	}
+	return steps, nil
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3342,6 +3811,16 @@ type (
 	}
 )
 
+// BatchSize sets the maximum number of rows inserted in one statement by
+// the CreateBulk builders. Larger batches are split into chunks that are
+// executed in one transaction, regardless of this option, in case they
+// exceed the placeholder limit of the database dialect.
+func BatchSize(n int) Option {
+	return func(c *config) {
+		c.batchSize = n
+	}
+}
+
 // ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
 // See, database/sql#DB.ExecContext for more information.
 func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
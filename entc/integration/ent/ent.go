@@ -305,6 +305,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -645,5 +653,337 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	api.Table: {
+		label: api.Label,
+		fields: map[string]string{
+			api.FieldID: "id",
+		},
+	},
+	builder.Table: {
+		label: builder.Label,
+		fields: map[string]string{
+			builder.FieldID: "id",
+		},
+	},
+	card.Table: {
+		label: card.Label,
+		fields: map[string]string{
+			card.FieldID:         "id",
+			card.FieldCreateTime: "create_time",
+			card.FieldUpdateTime: "update_time",
+			card.FieldBalance:    "balance",
+			card.FieldNumber:     "number",
+			card.FieldName:       "name",
+		},
+	},
+	comment.Table: {
+		label: comment.Label,
+		fields: map[string]string{
+			comment.FieldID:          "id",
+			comment.FieldUniqueInt:   "unique_int",
+			comment.FieldUniqueFloat: "unique_float",
+			comment.FieldNillableInt: "nillable_int",
+			comment.FieldTable:       "table",
+			comment.FieldDir:         "dir",
+			comment.FieldClient:      "client",
+		},
+	},
+	exvaluescan.Table: {
+		label: exvaluescan.Label,
+		fields: map[string]string{
+			exvaluescan.FieldID:             "id",
+			exvaluescan.FieldBinary:         "binary",
+			exvaluescan.FieldBinaryBytes:    "binary_bytes",
+			exvaluescan.FieldBinaryOptional: "binary_optional",
+			exvaluescan.FieldText:           "text",
+			exvaluescan.FieldTextOptional:   "text_optional",
+			exvaluescan.FieldBase64:         "base64",
+			exvaluescan.FieldCustom:         "custom",
+			exvaluescan.FieldCustomOptional: "custom_optional",
+		},
+	},
+	fieldtype.Table: {
+		label: fieldtype.Label,
+		fields: map[string]string{
+			fieldtype.FieldID:                    "id",
+			fieldtype.FieldInt:                   "int",
+			fieldtype.FieldInt8:                  "int8",
+			fieldtype.FieldInt16:                 "int16",
+			fieldtype.FieldInt32:                 "int32",
+			fieldtype.FieldInt64:                 "int64",
+			fieldtype.FieldOptionalInt:           "optional_int",
+			fieldtype.FieldOptionalInt8:          "optional_int8",
+			fieldtype.FieldOptionalInt16:         "optional_int16",
+			fieldtype.FieldOptionalInt32:         "optional_int32",
+			fieldtype.FieldOptionalInt64:         "optional_int64",
+			fieldtype.FieldNillableInt:           "nillable_int",
+			fieldtype.FieldNillableInt8:          "nillable_int8",
+			fieldtype.FieldNillableInt16:         "nillable_int16",
+			fieldtype.FieldNillableInt32:         "nillable_int32",
+			fieldtype.FieldNillableInt64:         "nillable_int64",
+			fieldtype.FieldValidateOptionalInt32: "validate_optional_int32",
+			fieldtype.FieldOptionalUint:          "optional_uint",
+			fieldtype.FieldOptionalUint8:         "optional_uint8",
+			fieldtype.FieldOptionalUint16:        "optional_uint16",
+			fieldtype.FieldOptionalUint32:        "optional_uint32",
+			fieldtype.FieldOptionalUint64:        "optional_uint64",
+			fieldtype.FieldState:                 "state",
+			fieldtype.FieldOptionalFloat:         "optional_float",
+			fieldtype.FieldOptionalFloat32:       "optional_float32",
+			fieldtype.FieldText:                  "text",
+			fieldtype.FieldDatetime:              "datetime",
+			fieldtype.FieldDecimal:               "decimal",
+			fieldtype.FieldLinkOther:             "link_other",
+			fieldtype.FieldLinkOtherFunc:         "link_other_func",
+			fieldtype.FieldMAC:                   "mac",
+			fieldtype.FieldStringArray:           "string_array",
+			fieldtype.FieldPassword:              "password",
+			fieldtype.FieldStringScanner:         "string_scanner",
+			fieldtype.FieldDuration:              "duration",
+			fieldtype.FieldDir:                   "dir",
+			fieldtype.FieldNdir:                  "ndir",
+			fieldtype.FieldStr:                   "str",
+			fieldtype.FieldNullStr:               "null_str",
+			fieldtype.FieldLink:                  "link",
+			fieldtype.FieldNullLink:              "null_link",
+			fieldtype.FieldActive:                "active",
+			fieldtype.FieldNullActive:            "null_active",
+			fieldtype.FieldDeleted:               "deleted",
+			fieldtype.FieldDeletedAt:             "deleted_at",
+			fieldtype.FieldRawData:               "raw_data",
+			fieldtype.FieldSensitive:             "sensitive",
+			fieldtype.FieldIP:                    "ip",
+			fieldtype.FieldNullInt64:             "null_int64",
+			fieldtype.FieldSchemaInt:             "schema_int",
+			fieldtype.FieldSchemaInt8:            "schema_int8",
+			fieldtype.FieldSchemaInt64:           "schema_int64",
+			fieldtype.FieldSchemaFloat:           "schema_float",
+			fieldtype.FieldSchemaFloat32:         "schema_float32",
+			fieldtype.FieldNullFloat:             "null_float",
+			fieldtype.FieldRole:                  "role",
+			fieldtype.FieldPriority:              "priority",
+			fieldtype.FieldOptionalUUID:          "optional_uuid",
+			fieldtype.FieldNillableUUID:          "nillable_uuid",
+			fieldtype.FieldStrings:               "strings",
+			fieldtype.FieldPair:                  "pair",
+			fieldtype.FieldNilPair:               "nil_pair",
+			fieldtype.FieldVstring:               "vstring",
+			fieldtype.FieldTriple:                "triple",
+			fieldtype.FieldBigInt:                "big_int",
+			fieldtype.FieldPasswordOther:         "password_other",
+		},
+	},
+	file.Table: {
+		label: file.Label,
+		fields: map[string]string{
+			file.FieldID:         "id",
+			file.FieldSetID:      "set_id",
+			file.FieldSize:       "size",
+			file.FieldName:       "name",
+			file.FieldUser:       "user",
+			file.FieldGroup:      "group",
+			file.FieldOp:         "op",
+			file.FieldFieldID:    "field_id",
+			file.FieldCreateTime: "create_time",
+		},
+	},
+	filetype.Table: {
+		label: filetype.Label,
+		fields: map[string]string{
+			filetype.FieldID:    "id",
+			filetype.FieldName:  "name",
+			filetype.FieldType:  "type",
+			filetype.FieldState: "state",
+		},
+	},
+	goods.Table: {
+		label: goods.Label,
+		fields: map[string]string{
+			goods.FieldID: "id",
+		},
+	},
+	group.Table: {
+		label: group.Label,
+		fields: map[string]string{
+			group.FieldID:       "id",
+			group.FieldActive:   "active",
+			group.FieldExpire:   "expire",
+			group.FieldType:     "type",
+			group.FieldMaxUsers: "max_users",
+			group.FieldName:     "name",
+		},
+	},
+	groupinfo.Table: {
+		label: groupinfo.Label,
+		fields: map[string]string{
+			groupinfo.FieldID:       "id",
+			groupinfo.FieldDesc:     "desc",
+			groupinfo.FieldMaxUsers: "max_users",
+		},
+	},
+	item.Table: {
+		label: item.Label,
+		fields: map[string]string{
+			item.FieldID:   "id",
+			item.FieldText: "text",
+		},
+	},
+	license.Table: {
+		label: license.Label,
+		fields: map[string]string{
+			license.FieldID:         "id",
+			license.FieldCreateTime: "create_time",
+			license.FieldUpdateTime: "update_time",
+		},
+	},
+	node.Table: {
+		label: node.Label,
+		fields: map[string]string{
+			node.FieldID:        "id",
+			node.FieldValue:     "value",
+			node.FieldUpdatedAt: "updated_at",
+		},
+	},
+	pc.Table: {
+		label: pc.Label,
+		fields: map[string]string{
+			pc.FieldID: "id",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID:           "id",
+			pet.FieldAge:          "age",
+			pet.FieldName:         "name",
+			pet.FieldUUID:         "uuid",
+			pet.FieldNickname:     "nickname",
+			pet.FieldTrained:      "trained",
+			pet.FieldOptionalTime: "optional_time",
+		},
+	},
+	spec.Table: {
+		label: spec.Label,
+		fields: map[string]string{
+			spec.FieldID: "id",
+		},
+	},
+	enttask.Table: {
+		label: enttask.Label,
+		fields: map[string]string{
+			enttask.FieldID:          "id",
+			enttask.FieldPriority:    "priority",
+			enttask.FieldPriorities:  "priorities",
+			enttask.FieldCreatedAt:   "created_at",
+			enttask.FieldName:        "name",
+			enttask.FieldOwner:       "owner",
+			enttask.FieldOrder:       "order",
+			enttask.FieldOrderOption: "order_option",
+			enttask.FieldOp:          "op",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:          "id",
+			user.FieldOptionalInt: "optional_int",
+			user.FieldAge:         "age",
+			user.FieldName:        "name",
+			user.FieldLast:        "last",
+			user.FieldNickname:    "nickname",
+			user.FieldAddress:     "address",
+			user.FieldPhone:       "phone",
+			user.FieldPassword:    "password",
+			user.FieldRole:        "role",
+			user.FieldEmployment:  "employment",
+			user.FieldSSOCert:     "SSOCert",
+			user.FieldFilesCount:  "files_count",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"cards_user_card_key":                  {table: "cards", columns: []string{"user_card"}},
+	"card_number":                          {table: "cards", columns: []string{"number"}},
+	"cards_users_card":                     {table: "cards", columns: []string{"user_card"}},
+	"comments_unique_int_key":              {table: "comments", columns: []string{"unique_int"}},
+	"comments_unique_float_key":            {table: "comments", columns: []string{"unique_float"}},
+	"field_types_files_field":              {table: "field_types", columns: []string{"file_field"}},
+	"files_create_time_key":                {table: "files", columns: []string{"create_time"}},
+	"file_name_user":                       {table: "files", columns: []string{"name", "user"}},
+	"file_name_user_files_file_type_files": {table: "files", columns: []string{"name", "user_files", "file_type_files"}},
+	"files_file_types_files":               {table: "files", columns: []string{"file_type_files"}},
+	"files_groups_files":                   {table: "files", columns: []string{"group_files"}},
+	"files_users_files":                    {table: "files", columns: []string{"user_files"}},
+	"file_types_name_key":                  {table: "file_types", columns: []string{"name"}},
+	"groups_group_infos_info":              {table: "groups", columns: []string{"group_info"}},
+	"items_text_key":                       {table: "items", columns: []string{"text"}},
+	"nodes_node_next_key":                  {table: "nodes", columns: []string{"node_next"}},
+	"nodes_nodes_next":                     {table: "nodes", columns: []string{"node_next"}},
+	"pet_user_team_key":                    {table: "pet", columns: []string{"user_team"}},
+	"pet_nickname":                         {table: "pet", columns: []string{"nickname"}},
+	"pet_users_pets":                       {table: "pet", columns: []string{"user_pets"}},
+	"pet_users_team":                       {table: "pet", columns: []string{"user_team"}},
+	"task_name_owner":                      {table: "tasks", columns: []string{"name", "owner"}},
+	"users_nickname_key":                   {table: "users", columns: []string{"nickname"}},
+	"users_phone_key":                      {table: "users", columns: []string{"phone"}},
+	"users_user_spouse_key":                {table: "users", columns: []string{"user_spouse"}},
+	"users_groups_blocked":                 {table: "users", columns: []string{"group_blocked"}},
+	"users_users_spouse":                   {table: "users", columns: []string{"user_spouse"}},
+	"users_users_parent":                   {table: "users", columns: []string{"user_parent"}},
+	"spec_card_spec_id":                    {table: "spec_card", columns: []string{"spec_id"}},
+	"spec_card_card_id":                    {table: "spec_card", columns: []string{"card_id"}},
+	"user_groups_user_id":                  {table: "user_groups", columns: []string{"user_id"}},
+	"user_groups_group_id":                 {table: "user_groups", columns: []string{"group_id"}},
+	"user_friends_user_id":                 {table: "user_friends", columns: []string{"user_id"}},
+	"user_friends_friend_id":               {table: "user_friends", columns: []string{"friend_id"}},
+	"user_following_user_id":               {table: "user_following", columns: []string{"user_id"}},
+	"user_following_follower_id":           {table: "user_following", columns: []string{"follower_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
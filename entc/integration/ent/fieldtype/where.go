@@ -215,7 +215,7 @@ func StringArray(v schema.Strings) predicate.FieldType {
 
 // Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
 func Password(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEQ(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEQ(FieldPassword, v)))
 }
 
 // StringScanner applies equality check predicate on the "string_scanner" field. It's identical to StringScannerEQ.
@@ -290,7 +290,7 @@ func RawData(v []byte) predicate.FieldType {
 
 // Sensitive applies equality check predicate on the "sensitive" field. It's identical to SensitiveEQ.
 func Sensitive(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEQ(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEQ(FieldSensitive, v)))
 }
 
 // IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
@@ -376,7 +376,7 @@ func BigInt(v schema.BigInt) predicate.FieldType {
 
 // PasswordOther applies equality check predicate on the "password_other" field. It's identical to PasswordOtherEQ.
 func PasswordOther(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEQ(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEQ(FieldPasswordOther, v)))
 }
 
 // IntEQ applies the EQ predicate on the "int" field.
@@ -1916,57 +1916,57 @@ func StringArrayNotNil() predicate.FieldType {
 
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEQ(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEQ(FieldPassword, v)))
 }
 
 // PasswordNEQ applies the NEQ predicate on the "password" field.
 func PasswordNEQ(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldNEQ(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldNEQ(FieldPassword, v)))
 }
 
 // PasswordIn applies the In predicate on the "password" field.
 func PasswordIn(vs ...string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldIn(FieldPassword, vs...))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldIn(FieldPassword, vs...)))
 }
 
 // PasswordNotIn applies the NotIn predicate on the "password" field.
 func PasswordNotIn(vs ...string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldNotIn(FieldPassword, vs...))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldNotIn(FieldPassword, vs...)))
 }
 
 // PasswordGT applies the GT predicate on the "password" field.
 func PasswordGT(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldGT(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldGT(FieldPassword, v)))
 }
 
 // PasswordGTE applies the GTE predicate on the "password" field.
 func PasswordGTE(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldGTE(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldGTE(FieldPassword, v)))
 }
 
 // PasswordLT applies the LT predicate on the "password" field.
 func PasswordLT(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldLT(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldLT(FieldPassword, v)))
 }
 
 // PasswordLTE applies the LTE predicate on the "password" field.
 func PasswordLTE(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldLTE(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldLTE(FieldPassword, v)))
 }
 
 // PasswordContains applies the Contains predicate on the "password" field.
 func PasswordContains(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldContains(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldContains(FieldPassword, v)))
 }
 
 // PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
 func PasswordHasPrefix(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldHasPrefix(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldHasPrefix(FieldPassword, v)))
 }
 
 // PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
 func PasswordHasSuffix(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldHasSuffix(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldHasSuffix(FieldPassword, v)))
 }
 
 // PasswordIsNil applies the IsNil predicate on the "password" field.
@@ -1981,12 +1981,12 @@ func PasswordNotNil() predicate.FieldType {
 
 // PasswordEqualFold applies the EqualFold predicate on the "password" field.
 func PasswordEqualFold(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEqualFold(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEqualFold(FieldPassword, v)))
 }
 
 // PasswordContainsFold applies the ContainsFold predicate on the "password" field.
 func PasswordContainsFold(v string) predicate.FieldType {
-	return predicate.FieldType(sql.FieldContainsFold(FieldPassword, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldContainsFold(FieldPassword, v)))
 }
 
 // StringScannerEQ applies the EQ predicate on the "string_scanner" field.
@@ -2797,42 +2797,42 @@ func RawDataNotNil() predicate.FieldType {
 
 // SensitiveEQ applies the EQ predicate on the "sensitive" field.
 func SensitiveEQ(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEQ(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEQ(FieldSensitive, v)))
 }
 
 // SensitiveNEQ applies the NEQ predicate on the "sensitive" field.
 func SensitiveNEQ(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldNEQ(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldNEQ(FieldSensitive, v)))
 }
 
 // SensitiveIn applies the In predicate on the "sensitive" field.
 func SensitiveIn(vs ...[]byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldIn(FieldSensitive, vs...))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldIn(FieldSensitive, vs...)))
 }
 
 // SensitiveNotIn applies the NotIn predicate on the "sensitive" field.
 func SensitiveNotIn(vs ...[]byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldNotIn(FieldSensitive, vs...))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldNotIn(FieldSensitive, vs...)))
 }
 
 // SensitiveGT applies the GT predicate on the "sensitive" field.
 func SensitiveGT(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldGT(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldGT(FieldSensitive, v)))
 }
 
 // SensitiveGTE applies the GTE predicate on the "sensitive" field.
 func SensitiveGTE(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldGTE(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldGTE(FieldSensitive, v)))
 }
 
 // SensitiveLT applies the LT predicate on the "sensitive" field.
 func SensitiveLT(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldLT(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldLT(FieldSensitive, v)))
 }
 
 // SensitiveLTE applies the LTE predicate on the "sensitive" field.
 func SensitiveLTE(v []byte) predicate.FieldType {
-	return predicate.FieldType(sql.FieldLTE(FieldSensitive, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldLTE(FieldSensitive, v)))
 }
 
 // SensitiveIsNil applies the IsNil predicate on the "sensitive" field.
@@ -3751,42 +3751,42 @@ func BigIntNotNil() predicate.FieldType {
 
 // PasswordOtherEQ applies the EQ predicate on the "password_other" field.
 func PasswordOtherEQ(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldEQ(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldEQ(FieldPasswordOther, v)))
 }
 
 // PasswordOtherNEQ applies the NEQ predicate on the "password_other" field.
 func PasswordOtherNEQ(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldNEQ(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldNEQ(FieldPasswordOther, v)))
 }
 
 // PasswordOtherIn applies the In predicate on the "password_other" field.
 func PasswordOtherIn(vs ...schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldIn(FieldPasswordOther, vs...))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldIn(FieldPasswordOther, vs...)))
 }
 
 // PasswordOtherNotIn applies the NotIn predicate on the "password_other" field.
 func PasswordOtherNotIn(vs ...schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldNotIn(FieldPasswordOther, vs...))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldNotIn(FieldPasswordOther, vs...)))
 }
 
 // PasswordOtherGT applies the GT predicate on the "password_other" field.
 func PasswordOtherGT(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldGT(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldGT(FieldPasswordOther, v)))
 }
 
 // PasswordOtherGTE applies the GTE predicate on the "password_other" field.
 func PasswordOtherGTE(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldGTE(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldGTE(FieldPasswordOther, v)))
 }
 
 // PasswordOtherLT applies the LT predicate on the "password_other" field.
 func PasswordOtherLT(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldLT(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldLT(FieldPasswordOther, v)))
 }
 
 // PasswordOtherLTE applies the LTE predicate on the "password_other" field.
 func PasswordOtherLTE(v schema.Password) predicate.FieldType {
-	return predicate.FieldType(sql.FieldLTE(FieldPasswordOther, v))
+	return predicate.FieldType(sql.SensitivePredicate(sql.FieldLTE(FieldPasswordOther, v)))
 }
 
 // PasswordOtherIsNil applies the IsNil predicate on the "password_other" field.
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
@@ -957,7 +958,7 @@ func (_c *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
 	_node, _spec := _c.createSpec()
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -1099,7 +1100,7 @@ func (_c *FieldTypeCreate) createSpec() (*FieldType, *sqlgraph.CreateSpec) {
 		_node.StringArray = value
 	}
 	if value, ok := _c.mutation.Password(); ok {
-		_spec.SetField(fieldtype.FieldPassword, field.TypeString, value)
+		_spec.SetField(fieldtype.FieldPassword, field.TypeString, dialect.Sensitive{V: value})
 		_node.Password = value
 	}
 	if value, ok := _c.mutation.StringScanner(); ok {
@@ -1155,7 +1156,7 @@ func (_c *FieldTypeCreate) createSpec() (*FieldType, *sqlgraph.CreateSpec) {
 		_node.RawData = value
 	}
 	if value, ok := _c.mutation.Sensitive(); ok {
-		_spec.SetField(fieldtype.FieldSensitive, field.TypeBytes, value)
+		_spec.SetField(fieldtype.FieldSensitive, field.TypeBytes, dialect.Sensitive{V: value})
 		_node.Sensitive = value
 	}
 	if value, ok := _c.mutation.IP(); ok {
@@ -1231,7 +1232,7 @@ func (_c *FieldTypeCreate) createSpec() (*FieldType, *sqlgraph.CreateSpec) {
 		_node.BigInt = value
 	}
 	if value, ok := _c.mutation.PasswordOther(); ok {
-		_spec.SetField(fieldtype.FieldPasswordOther, field.TypeOther, value)
+		_spec.SetField(fieldtype.FieldPasswordOther, field.TypeOther, dialect.Sensitive{V: value})
 		_node.PasswordOther = value
 	}
 	return _node, _spec
@@ -1960,7 +1961,7 @@ func (u *FieldTypeUpsert) ClearStringArray() *FieldTypeUpsert {
 
 // SetPassword sets the "password" field.
 func (u *FieldTypeUpsert) SetPassword(v string) *FieldTypeUpsert {
-	u.Set(fieldtype.FieldPassword, v)
+	u.Set(fieldtype.FieldPassword, dialect.Sensitive{V: v})
 	return u
 }
 
@@ -2212,7 +2213,7 @@ func (u *FieldTypeUpsert) ClearRawData() *FieldTypeUpsert {
 
 // SetSensitive sets the "sensitive" field.
 func (u *FieldTypeUpsert) SetSensitive(v []byte) *FieldTypeUpsert {
-	u.Set(fieldtype.FieldSensitive, v)
+	u.Set(fieldtype.FieldSensitive, dialect.Sensitive{V: v})
 	return u
 }
 
@@ -2566,7 +2567,7 @@ func (u *FieldTypeUpsert) ClearBigInt() *FieldTypeUpsert {
 
 // SetPasswordOther sets the "password_other" field.
 func (u *FieldTypeUpsert) SetPasswordOther(v schema.Password) *FieldTypeUpsert {
-	u.Set(fieldtype.FieldPasswordOther, v)
+	u.Set(fieldtype.FieldPasswordOther, dialect.Sensitive{V: v})
 	return u
 }
 
@@ -4201,12 +4202,12 @@ func (_c *FieldTypeCreateBulk) Save(ctx context.Context) ([]*FieldType, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs, BatchSize: _c.batchSize}
 					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
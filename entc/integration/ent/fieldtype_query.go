@@ -570,6 +570,9 @@ func (_s *FieldTypeSelect) sqlScan(ctx context.Context, root *FieldTypeQuery, v
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
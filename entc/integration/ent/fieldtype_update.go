@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
@@ -1448,8 +1449,58 @@ func (_u *FieldTypeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Fiel
 }
 
 func (_u *FieldTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fieldtype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SaveReturning executes the query and returns the updated FieldType entities. PostgreSQL and SQLite
+// use the RETURNING clause, and MySQL locks the matched rows and queries them after the update.
+func (_u *FieldTypeUpdate) SaveReturning(ctx context.Context) ([]*FieldType, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSaveReturning, _u.mutation, _u.hooks)
+}
+
+func (_u *FieldTypeUpdate) sqlSaveReturning(ctx context.Context) (nodes []*FieldType, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FieldType).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FieldType{config: _u.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if _, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fieldtype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return nodes, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *FieldTypeUpdate) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	if err := _u.check(); err != nil {
-		return _node, err
+		return nil, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(fieldtype.Table, fieldtype.Columns, sqlgraph.NewFieldSpec(fieldtype.FieldID, field.TypeInt))
 	if ps := _u.mutation.Predicates(); len(ps) > 0 {
@@ -1703,7 +1754,7 @@ func (_u *FieldTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.ClearField(fieldtype.FieldStringArray, field.TypeOther)
 	}
 	if value, ok := _u.mutation.Password(); ok {
-		_spec.SetField(fieldtype.FieldPassword, field.TypeString, value)
+		_spec.SetField(fieldtype.FieldPassword, field.TypeString, dialect.Sensitive{V: value})
 	}
 	if _u.mutation.PasswordCleared() {
 		_spec.ClearField(fieldtype.FieldPassword, field.TypeString)
@@ -1787,7 +1838,7 @@ func (_u *FieldTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.ClearField(fieldtype.FieldRawData, field.TypeBytes)
 	}
 	if value, ok := _u.mutation.Sensitive(); ok {
-		_spec.SetField(fieldtype.FieldSensitive, field.TypeBytes, value)
+		_spec.SetField(fieldtype.FieldSensitive, field.TypeBytes, dialect.Sensitive{V: value})
 	}
 	if _u.mutation.SensitiveCleared() {
 		_spec.ClearField(fieldtype.FieldSensitive, field.TypeBytes)
@@ -1912,22 +1963,13 @@ func (_u *FieldTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.ClearField(fieldtype.FieldBigInt, field.TypeInt)
 	}
 	if value, ok := _u.mutation.PasswordOther(); ok {
-		_spec.SetField(fieldtype.FieldPasswordOther, field.TypeOther, value)
+		_spec.SetField(fieldtype.FieldPasswordOther, field.TypeOther, dialect.Sensitive{V: value})
 	}
 	if _u.mutation.PasswordOtherCleared() {
 		_spec.ClearField(fieldtype.FieldPasswordOther, field.TypeOther)
 	}
 	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{fieldtype.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
+	return _spec, nil
 }
 
 // FieldTypeUpdateOne is the builder for updating a single FieldType entity.
@@ -3361,8 +3403,29 @@ func (_u *FieldTypeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *F
 }
 
 func (_u *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType, err error) {
+	_spec, err := _u.updateSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_node = &FieldType{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fieldtype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = newConstraintError(err)
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// updateSpec returns the sqlgraph.UpdateSpec of the builder.
+func (_u *FieldTypeUpdateOne) updateSpec(ctx context.Context) (*sqlgraph.UpdateSpec, error) {
 	if err := _u.check(); err != nil {
-		return _node, err
+		return nil, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(fieldtype.Table, fieldtype.Columns, sqlgraph.NewFieldSpec(fieldtype.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
@@ -3633,7 +3696,7 @@ func (_u *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType, er
 		_spec.ClearField(fieldtype.FieldStringArray, field.TypeOther)
 	}
 	if value, ok := _u.mutation.Password(); ok {
-		_spec.SetField(fieldtype.FieldPassword, field.TypeString, value)
+		_spec.SetField(fieldtype.FieldPassword, field.TypeString, dialect.Sensitive{V: value})
 	}
 	if _u.mutation.PasswordCleared() {
 		_spec.ClearField(fieldtype.FieldPassword, field.TypeString)
@@ -3717,7 +3780,7 @@ func (_u *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType, er
 		_spec.ClearField(fieldtype.FieldRawData, field.TypeBytes)
 	}
 	if value, ok := _u.mutation.Sensitive(); ok {
-		_spec.SetField(fieldtype.FieldSensitive, field.TypeBytes, value)
+		_spec.SetField(fieldtype.FieldSensitive, field.TypeBytes, dialect.Sensitive{V: value})
 	}
 	if _u.mutation.SensitiveCleared() {
 		_spec.ClearField(fieldtype.FieldSensitive, field.TypeBytes)
@@ -3842,23 +3905,98 @@ func (_u *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType, er
 		_spec.ClearField(fieldtype.FieldBigInt, field.TypeInt)
 	}
 	if value, ok := _u.mutation.PasswordOther(); ok {
-		_spec.SetField(fieldtype.FieldPasswordOther, field.TypeOther, value)
+		_spec.SetField(fieldtype.FieldPasswordOther, field.TypeOther, dialect.Sensitive{V: value})
 	}
 	if _u.mutation.PasswordOtherCleared() {
 		_spec.ClearField(fieldtype.FieldPasswordOther, field.TypeOther)
 	}
 	_spec.AddModifiers(_u.modifiers...)
-	_node = &FieldType{config: _u.config}
-	_spec.Assign = _node.assignValues
-	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{fieldtype.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	return _spec, nil
+}
+
+// FieldTypeUpdateBulk is the builder for updating many FieldType entities in bulk,
+// where each entity is updated by its own FieldTypeUpdateOne builder.
+type FieldTypeUpdateBulk struct {
+	config
+	builders []*FieldTypeUpdateOne
+}
+
+// Save updates the FieldType entities in the database, and returns them in the order of their builders.
+// The hooks of each builder are executed on its mutation, and the entities that change the same fields are
+// updated using one statement, or several statements in case the number of arguments exceeds the limit of
+// the database.
+func (_u *FieldTypeUpdateBulk) Save(ctx context.Context) ([]*FieldType, error) {
+	specs := make([]*sqlgraph.UpdateSpec, len(_u.builders))
+	nodes := make([]*FieldType, len(_u.builders))
+	mutators := make([]Mutator, len(_u.builders))
+	for i := range _u.builders {
+		_u.builders[i].defaults()
+		func(i int, root context.Context) {
+			_builder := _u.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FieldTypeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				_builder.mutation = mutation
+				var err error
+				if specs[i], err = _builder.updateSpec(ctx); err != nil {
+					return nil, err
+				}
+				nodes[i] = &FieldType{config: _builder.config}
+				specs[i].Assign = nodes[i].assignValues
+				specs[i].ScanValues = nodes[i].scanValues
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _u.builders[i+1].mutation)
+				} else {
+					_spec := &sqlgraph.BatchUpdateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchUpdate(ctx, _u.driver, _spec); err != nil {
+						if _, ok := err.(*sqlgraph.NotFoundError); ok {
+							err = &NotFoundError{fieldtype.Label}
+						} else if sqlgraph.IsConstraintError(err) {
+							err = newConstraintError(err)
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(_builder.hooks) - 1; i >= 0; i-- {
+				mut = _builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _u.builders[0].mutation); err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FieldTypeUpdateBulk) SaveX(ctx context.Context) []*FieldType {
+	v, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_u *FieldTypeUpdateBulk) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FieldTypeUpdateBulk) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -13,7 +13,6 @@ import (
 	"net/url"
 	"time"
 
-	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/entc/integration/ent/card"
 	"entgo.io/ent/entc/integration/ent/exvaluescan"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
@@ -29,6 +28,7 @@ import (
 	enttask "entgo.io/ent/entc/integration/ent/task"
 	"entgo.io/ent/entc/integration/ent/user"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 )
 
@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 )
 
 // Tx is a transactional client that is created by calling Client.Tx().
@@ -186,6 +187,103 @@ func (tx *Tx) Client() *Client {
 	return tx.client
 }
 
+// Tx returns a nested transactional client that is backed by a savepoint
+// of the transaction. See Tx.Savepoint for more information.
+func (tx *Tx) Tx(ctx context.Context) (*Tx, error) {
+	return tx.Savepoint(ctx, "")
+}
+
+// Savepoint creates a savepoint with the given name in the transaction, and returns a nested
+// transactional client that is backed by it. Committing the nested transaction releases the
+// savepoint, and rolling it back discards the changes that were made after the savepoint was
+// created, without affecting the parent transaction. If the name is empty, a unique name is
+// generated.
+//
+// Note that hooks that were registered on the nested transaction are executed when it is
+// committed or rolled back, and not when the parent transaction completes.
+func (tx *Tx) Savepoint(ctx context.Context, name string) (*Tx, error) {
+	txd := tx.config.driver.(*txDriver)
+	if name == "" {
+		prefix := txd.savepoint
+		if prefix == "" {
+			prefix = "ent_sp"
+		}
+		txd.mu.Lock()
+		txd.savepoints++
+		name = fmt.Sprintf("%s_%d", prefix, txd.savepoints)
+		txd.mu.Unlock()
+	}
+	sp := &savepointTx{ctx: ctx, drv: txd, name: name}
+	if err := sp.exec("SAVEPOINT "); err != nil {
+		return nil, fmt.Errorf("ent: creating savepoint %q: %w", name, err)
+	}
+	cfg := tx.config
+	cfg.driver = &txDriver{tx: sp, drv: txd.drv, savepoint: name}
+	return &Tx{
+		ctx:         ctx,
+		config:      cfg,
+		Api:         NewAPIClient(cfg),
+		Builder:     NewBuilderClient(cfg),
+		Card:        NewCardClient(cfg),
+		Comment:     NewCommentClient(cfg),
+		ExValueScan: NewExValueScanClient(cfg),
+		FieldType:   NewFieldTypeClient(cfg),
+		File:        NewFileClient(cfg),
+		FileType:    NewFileTypeClient(cfg),
+		Goods:       NewGoodsClient(cfg),
+		Group:       NewGroupClient(cfg),
+		GroupInfo:   NewGroupInfoClient(cfg),
+		Item:        NewItemClient(cfg),
+		License:     NewLicenseClient(cfg),
+		Node:        NewNodeClient(cfg),
+		PC:          NewPCClient(cfg),
+		Pet:         NewPetClient(cfg),
+		Spec:        NewSpecClient(cfg),
+		Task:        NewTaskClient(cfg),
+		User:        NewUserClient(cfg),
+	}, nil
+}
+
+// savepointTx implements the dialect.Tx interface for nested transactions. Statements
+// are executed by the parent transaction, and Commit and Rollback release and roll back
+// to the savepoint.
+type savepointTx struct {
+	ctx  context.Context
+	drv  *txDriver
+	name string
+}
+
+// Exec calls the Exec of the parent transaction.
+func (tx *savepointTx) Exec(ctx context.Context, query string, args, v any) error {
+	return tx.drv.Exec(ctx, query, args, v)
+}
+
+// Query calls the Query of the parent transaction.
+func (tx *savepointTx) Query(ctx context.Context, query string, args, v any) error {
+	return tx.drv.Query(ctx, query, args, v)
+}
+
+// Commit releases the savepoint.
+func (tx *savepointTx) Commit() error {
+	return tx.exec("RELEASE SAVEPOINT ")
+}
+
+// Rollback rolls back to the savepoint, and releases it.
+func (tx *savepointTx) Rollback() error {
+	if err := tx.exec("ROLLBACK TO SAVEPOINT "); err != nil {
+		return err
+	}
+	return tx.exec("RELEASE SAVEPOINT ")
+}
+
+// exec executes the given savepoint statement on the parent transaction.
+func (tx *savepointTx) exec(stmt string) error {
+	query := sql.Dialect(tx.drv.Dialect()).String(func(b *sql.Builder) {
+		b.WriteString(stmt).Ident(tx.name)
+	})
+	return tx.drv.Exec(tx.ctx, query, []any{}, nil)
+}
+
 func (tx *Tx) init() {
 	tx.Api = NewAPIClient(tx.config)
 	tx.Builder = NewBuilderClient(tx.config)
@@ -228,6 +326,10 @@ type txDriver struct {
 	mu         sync.Mutex
 	onCommit   []CommitHook
 	onRollback []RollbackHook
+	// savepoint holds the name of the savepoint that backs the
+	// transaction, and the number of savepoints created in it.
+	savepoint  string
+	savepoints int
 }
 
 // newTx creates a new transactional driver.
@@ -292,3 +394,13 @@ func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any)
 	}
 	return q.QueryContext(ctx, query, args...)
 }
+
+// ExecContext calls the ExecContext method of the parent transaction.
+func (tx *savepointTx) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	return tx.drv.ExecContext(ctx, query, args...)
+}
+
+// QueryContext calls the QueryContext method of the parent transaction.
+func (tx *savepointTx) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	return tx.drv.QueryContext(ctx, query, args...)
+}
@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
 	"entgo.io/ent/entc/integration/gremlin/ent/api"
 	"entgo.io/ent/entc/integration/gremlin/ent/builder"
@@ -2316,6 +2317,75 @@ func (c *NodeClient) QueryNext(_m *Node) *NodeQuery {
 	return query
 }
 
+// Path returns the IDs of the Nodes along the shortest path from the Node with
+// the from ID to the Node with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to Node is not reachable from the from Node.
+//
+//	ids, err := client.Node.Path(ctx, a.ID, b.ID, node.EdgePrev)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *NodeClient) Path(ctx context.Context, from, to string, edges ...string) ([]string, error) {
+	traversal, err := c.pathTraversal(from, to, edges)
+	if err != nil {
+		return nil, err
+	}
+	res := &gremlin.Response{}
+	query, bindings := traversal.Limit(1).Path().Unfold().ID().Query()
+	if err := c.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	var ids []string
+	if err := res.ReadVal(&ids); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, &NotFoundError{node.Label}
+	}
+	return ids, nil
+}
+
+// Reachable reports if the Node with the to ID is reachable from the Node with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *NodeClient) Reachable(ctx context.Context, from, to string, edges ...string) (bool, error) {
+	traversal, err := c.pathTraversal(from, to, edges)
+	if err != nil {
+		return false, err
+	}
+	res := &gremlin.Response{}
+	query, bindings := traversal.Limit(1).Count().Query()
+	if err := c.driver.Exec(ctx, query, bindings, res); err != nil {
+		return false, err
+	}
+	n, err := res.ReadInt()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// pathTraversal returns a breadth-first traversal from the from vertex to the
+// to vertex, that repeatedly traverses the given edge names.
+func (c *NodeClient) pathTraversal(from, to string, edges []string) (*dsl.Traversal, error) {
+	if len(edges) == 0 {
+		edges = []string{node.EdgePrev, node.EdgeNext}
+	}
+	steps := make([]any, len(edges))
+	for i, e := range edges {
+		switch e {
+		case node.EdgePrev:
+			steps[i] = __.In(node.NextLabel)
+		case node.EdgeNext:
+			steps[i] = __.Out(node.NextLabel)
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of Node", e)
+		}
+	}
+	// The until step comes first to check the source vertex before traversing its edges,
+	// and simplePath prevents the traversal from visiting the same vertex more than once.
+	return g.V(from).Until(__.HasID(to)).Repeat(__.Union(steps...).SimplePath()), nil
+}
+
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
@@ -3135,6 +3205,83 @@ func (c *UserClient) QueryParent(_m *User) *UserQuery {
 	return query
 }
 
+// Path returns the IDs of the Users along the shortest path from the User with
+// the from ID to the User with the to ID, including both of them, by traversing the given
+// self-referencing edges, or all of them in case no edges were given. A *NotFoundError is returned
+// in case the to User is not reachable from the from User.
+//
+//	ids, err := client.User.Path(ctx, a.ID, b.ID, user.EdgeFriends)
+//
+// Note that path queries are executed directly on the database, and they do not invoke interceptors.
+func (c *UserClient) Path(ctx context.Context, from, to string, edges ...string) ([]string, error) {
+	traversal, err := c.pathTraversal(from, to, edges)
+	if err != nil {
+		return nil, err
+	}
+	res := &gremlin.Response{}
+	query, bindings := traversal.Limit(1).Path().Unfold().ID().Query()
+	if err := c.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	var ids []string
+	if err := res.ReadVal(&ids); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, &NotFoundError{user.Label}
+	}
+	return ids, nil
+}
+
+// Reachable reports if the User with the to ID is reachable from the User with the
+// from ID by traversing the given self-referencing edges, or all of them in case no edges were given.
+func (c *UserClient) Reachable(ctx context.Context, from, to string, edges ...string) (bool, error) {
+	traversal, err := c.pathTraversal(from, to, edges)
+	if err != nil {
+		return false, err
+	}
+	res := &gremlin.Response{}
+	query, bindings := traversal.Limit(1).Count().Query()
+	if err := c.driver.Exec(ctx, query, bindings, res); err != nil {
+		return false, err
+	}
+	n, err := res.ReadInt()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// pathTraversal returns a breadth-first traversal from the from vertex to the
+// to vertex, that repeatedly traverses the given edge names.
+func (c *UserClient) pathTraversal(from, to string, edges []string) (*dsl.Traversal, error) {
+	if len(edges) == 0 {
+		edges = []string{user.EdgeFriends, user.EdgeFollowers, user.EdgeFollowing, user.EdgeSpouse, user.EdgeChildren, user.EdgeParent}
+	}
+	steps := make([]any, len(edges))
+	for i, e := range edges {
+		switch e {
+		case user.EdgeFriends:
+			steps[i] = __.Both(user.FriendsLabel)
+		case user.EdgeFollowers:
+			steps[i] = __.In(user.FollowingLabel)
+		case user.EdgeFollowing:
+			steps[i] = __.Out(user.FollowingLabel)
+		case user.EdgeSpouse:
+			steps[i] = __.Both(user.SpouseLabel)
+		case user.EdgeChildren:
+			steps[i] = __.In(user.ParentLabel)
+		case user.EdgeParent:
+			steps[i] = __.Out(user.ParentLabel)
+		default:
+			return nil, fmt.Errorf("ent: edge %q cannot be used for path queries of User", e)
+		}
+	}
+	// The until step comes first to check the source vertex before traversing its edges,
+	// and simplePath prevents the traversal from visiting the same vertex more than once.
+	return g.V(from).Until(__.HasID(to)).Repeat(__.Union(steps...).SimplePath()), nil
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -7,7 +7,6 @@
 package ent
 
 import (
-	"database/sql"
 	"math/big"
 	"net"
 	"net/http"
@@ -29,6 +28,7 @@ import (
 	enttask "entgo.io/ent/entc/integration/gremlin/ent/task"
 	"entgo.io/ent/entc/integration/gremlin/ent/user"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 )
 
@@ -0,0 +1,114 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/history/ent"
+	"entgo.io/ent/entc/integration/history/ent/category"
+	"entgo.io/ent/entc/integration/history/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsOfEagerLoading(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	a := client.Category.Create().SetName("a").SaveX(ctx)
+	b := client.Category.Create().SetName("b").SaveX(ctx)
+	c := client.Category.Create().SetName("c").SetParent(a).SaveX(ctx)
+	time.Sleep(10 * time.Millisecond)
+	before := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	client.Category.UpdateOne(c).SetName("c2").SetParent(b).ExecX(ctx)
+
+	records := client.Category.History(c.ID).AllX(ctx)
+	require.Len(t, records, 2)
+	require.Equal(t, ent.HistoryOpCreate, records[0].Op)
+	require.Equal(t, ent.HistoryOpUpdate, records[1].Op)
+
+	// Edges that are stored as foreign-keys follow the past state.
+	past := client.Category.Query().
+		AsOf(before).
+		Where(category.ID(c.ID)).
+		WithParent().
+		OnlyX(ctx)
+	require.Equal(t, "c", past.Name)
+	require.NotNil(t, past.Edges.Parent)
+	require.Equal(t, a.ID, past.Edges.Parent.ID)
+
+	current := client.Category.Query().
+		Where(category.ID(c.ID)).
+		WithParent().
+		OnlyX(ctx)
+	require.Equal(t, "c2", current.Name)
+	require.Equal(t, b.ID, current.Edges.Parent.ID)
+}
+
+func TestSensitiveRedaction(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:redaction?mode=memory&_fk=1")
+	require.NoError(t, err)
+	var logs []string
+	client := ent.NewClient(ent.Driver(dialect.DebugWithContext(drv, func(_ context.Context, v ...any) {
+		logs = append(logs, fmt.Sprint(v...))
+	})))
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	c := client.Category.Create().SetName("a").SetSecret("s3cr3t").SaveX(ctx)
+	client.Category.UpdateOne(c).SetName("b").ExecX(ctx)
+	client.Category.DeleteOne(c).ExecX(ctx)
+	records := client.Category.History(c.ID).AllX(ctx)
+	require.Len(t, records, 3)
+	for _, r := range records {
+		require.Equal(t, "s3cr3t", r.Snapshot.Secret)
+	}
+	require.NotEmpty(t, logs)
+	for _, l := range logs {
+		require.NotContains(t, l, "s3cr3t")
+	}
+}
+
+func TestNonTransactional(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:non-tx?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a := client.Category.Create().SetName("a").SaveX(ctx)
+	client.Category.CreateBulk(
+		client.Category.Create().SetName("b"),
+		client.Category.Create().SetName("c"),
+	).ExecX(ctx)
+	// Returned entities are not bound to the internal transaction.
+	a = a.Update().SetName("a2").SaveX(ctx)
+	require.Equal(t, "a2", a.Name)
+	require.Len(t, client.Category.History(a.ID).AllX(ctx), 2)
+
+	// Mutations are rolled back in case their history cannot be recorded.
+	_, err = drv.DB().ExecContext(ctx, "DROP TABLE "+category.HistoryTable)
+	require.NoError(t, err)
+	require.Error(t, client.Category.Create().SetName("d").Exec(ctx))
+	require.Error(t, client.Category.CreateBulk(
+		client.Category.Create().SetName("e"),
+		client.Category.Create().SetName("f"),
+	).Exec(ctx))
+	require.Error(t, a.Update().SetName("a3").Exec(ctx))
+	_, err = client.Category.Delete().Exec(ctx)
+	require.Error(t, err)
+	names := client.Category.Query().Order(category.ByID()).Select(category.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a2", "b", "c"}, names)
+}
@@ -272,6 +272,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -612,5 +620,90 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	card.Table: {
+		label: card.Label,
+		fields: map[string]string{
+			card.FieldID:        "id",
+			card.FieldNumber:    "number",
+			card.FieldName:      "name",
+			card.FieldCreatedAt: "created_at",
+			card.FieldInHook:    "in_hook",
+			card.FieldExpiredAt: "expired_at",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID:         "id",
+			pet.FieldDeleteTime: "delete_time",
+			pet.FieldName:       "name",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:       "id",
+			user.FieldVersion:  "version",
+			user.FieldName:     "name",
+			user.FieldWorth:    "worth",
+			user.FieldPassword: "password",
+			user.FieldActive:   "active",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"cards_users_cards":          {table: "cards", columns: []string{"user_cards"}},
+	"pets_users_pets":            {table: "pets", columns: []string{"user_pets"}},
+	"users_user_best_friend_key": {table: "users", columns: []string{"user_best_friend"}},
+	"users_users_best_friend":    {table: "users", columns: []string{"user_best_friend"}},
+	"user_friends_user_id":       {table: "user_friends", columns: []string{"user_id"}},
+	"user_friends_friend_id":     {table: "user_friends", columns: []string{"friend_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
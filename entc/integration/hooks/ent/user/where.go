@@ -74,7 +74,7 @@ func Worth(v uint) predicate.User {
 
 // Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
 func Password(v string) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldEQ(FieldPassword, v)))
 }
 
 // Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
@@ -239,57 +239,57 @@ func WorthNotNil() predicate.User {
 
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldEQ(FieldPassword, v)))
 }
 
 // PasswordNEQ applies the NEQ predicate on the "password" field.
 func PasswordNEQ(v string) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldNEQ(FieldPassword, v)))
 }
 
 // PasswordIn applies the In predicate on the "password" field.
 func PasswordIn(vs ...string) predicate.User {
-	return predicate.User(sql.FieldIn(FieldPassword, vs...))
+	return predicate.User(sql.SensitivePredicate(sql.FieldIn(FieldPassword, vs...)))
 }
 
 // PasswordNotIn applies the NotIn predicate on the "password" field.
 func PasswordNotIn(vs ...string) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldPassword, vs...))
+	return predicate.User(sql.SensitivePredicate(sql.FieldNotIn(FieldPassword, vs...)))
 }
 
 // PasswordGT applies the GT predicate on the "password" field.
 func PasswordGT(v string) predicate.User {
-	return predicate.User(sql.FieldGT(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldGT(FieldPassword, v)))
 }
 
 // PasswordGTE applies the GTE predicate on the "password" field.
 func PasswordGTE(v string) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldGTE(FieldPassword, v)))
 }
 
 // PasswordLT applies the LT predicate on the "password" field.
 func PasswordLT(v string) predicate.User {
-	return predicate.User(sql.FieldLT(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldLT(FieldPassword, v)))
 }
 
 // PasswordLTE applies the LTE predicate on the "password" field.
 func PasswordLTE(v string) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldLTE(FieldPassword, v)))
 }
 
 // PasswordContains applies the Contains predicate on the "password" field.
 func PasswordContains(v string) predicate.User {
-	return predicate.User(sql.FieldContains(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldContains(FieldPassword, v)))
 }
 
 // PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
 func PasswordHasPrefix(v string) predicate.User {
-	return predicate.User(sql.FieldHasPrefix(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldHasPrefix(FieldPassword, v)))
 }
 
 // PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
 func PasswordHasSuffix(v string) predicate.User {
-	return predicate.User(sql.FieldHasSuffix(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldHasSuffix(FieldPassword, v)))
 }
 
 // PasswordIsNil applies the IsNil predicate on the "password" field.
@@ -304,12 +304,12 @@ func PasswordNotNil() predicate.User {
 
 // PasswordEqualFold applies the EqualFold predicate on the "password" field.
 func PasswordEqualFold(v string) predicate.User {
-	return predicate.User(sql.FieldEqualFold(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldEqualFold(FieldPassword, v)))
 }
 
 // PasswordContainsFold applies the ContainsFold predicate on the "password" field.
 func PasswordContainsFold(v string) predicate.User {
-	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
+	return predicate.User(sql.SensitivePredicate(sql.FieldContainsFold(FieldPassword, v)))
 }
 
 // ActiveEQ applies the EQ predicate on the "active" field.
@@ -1698,9 +1698,20 @@ func Tx(t *testing.T, client *ent.Client) {
 		m.On("onRollback", nil).Once()
 		defer m.AssertExpectations(t)
 		tx.OnRollback(m.rHook())
-		_, err = tx.Client().Tx(ctx)
-		require.Error(t, err, "cannot start a transaction within a transaction")
+		// Nested transactions are backed by savepoints.
+		n := tx.Node.Query().CountX(ctx)
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.Node.Create().SaveX(ctx)
+		require.NoError(t, nested.Rollback())
+		require.Equal(t, n, tx.Node.Query().CountX(ctx), "rollback of nested transaction should discard its changes")
+		nested, err = tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.Node.Create().SaveX(ctx)
+		require.NoError(t, nested.Commit())
+		require.Equal(t, n+1, tx.Node.Query().CountX(ctx), "commit of nested transaction should keep its changes")
 		require.NoError(t, tx.Rollback())
+		require.Equal(t, n, client.Node.Query().CountX(ctx), "rollback should discard the changes of nested transactions")
 	})
 	t.Run("TxOptions Rollback", func(t *testing.T) {
 		skip(t, "SQLite")
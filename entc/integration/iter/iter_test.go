@@ -0,0 +1,118 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package iter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/iter/ent"
+	"entgo.io/ent/entc/integration/iter/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterTx(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	require.NoError(t, err)
+	require.NoError(t, ent.NewClient(ent.Driver(drv)).Schema.Create(ctx))
+	client := ent.NewClient(ent.Driver(&busyDriver{Driver: drv}))
+	defer client.Close()
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback()
+	for i := range 5 {
+		u := tx.User.Create().SetName(fmt.Sprintf("u%d", i)).SaveX(ctx)
+		tx.Pet.Create().SetName(fmt.Sprintf("p%d", i)).SetOwner(u).ExecX(ctx)
+	}
+	var names []string
+	for users, err := range tx.User.Query().Order(user.ByName(sql.OrderDesc())).WithPets().IterBatches(ctx, 2) {
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(users), 2)
+		for _, u := range users {
+			require.Len(t, u.Edges.Pets, 1)
+			require.Equal(t, "p"+u.Name[1:], u.Edges.Pets[0].Name)
+			names = append(names, u.Name)
+		}
+		// Other queries can be executed on the transaction while iterating.
+		require.Equal(t, 5, tx.Pet.Query().CountX(ctx))
+	}
+	require.Equal(t, []string{"u4", "u3", "u2", "u1", "u0"}, names)
+
+	names = nil
+	for u, err := range tx.User.Query().Order(user.ByName()).Offset(1).Limit(3).WithPets().Iter(ctx) {
+		require.NoError(t, err)
+		require.Len(t, u.Edges.Pets, 1)
+		if names = append(names, u.Name); len(names) == 2 {
+			break
+		}
+	}
+	require.Equal(t, []string{"u1", "u2"}, names)
+	require.NoError(t, tx.Commit())
+}
+
+// errBusy is returned by busyTx for statements that are executed while rows are open.
+var errBusy = errors.New("conn busy")
+
+// busyDriver is a driver whose transactions fail statements that are executed
+// while the rows of another query are open, like PostgreSQL and MySQL do.
+type busyDriver struct {
+	dialect.Driver
+}
+
+// Tx starts a busyTx.
+func (d *busyDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &busyTx{Tx: tx}, nil
+}
+
+type busyTx struct {
+	dialect.Tx
+	open int
+}
+
+func (tx *busyTx) Exec(ctx context.Context, query string, args, v any) error {
+	if tx.open > 0 {
+		return errBusy
+	}
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *busyTx) Query(ctx context.Context, query string, args, v any) error {
+	if tx.open > 0 {
+		return errBusy
+	}
+	if err := tx.Tx.Query(ctx, query, args, v); err != nil {
+		return err
+	}
+	rows := v.(*sql.Rows)
+	rows.ColumnScanner = &busyRows{ColumnScanner: rows.ColumnScanner, tx: tx}
+	tx.open++
+	return nil
+}
+
+type busyRows struct {
+	sql.ColumnScanner
+	tx     *busyTx
+	closed bool
+}
+
+func (r *busyRows) Close() error {
+	if !r.closed {
+		r.closed = true
+		r.tx.open--
+	}
+	return r.ColumnScanner.Close()
+}
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"encoding/json"
+	"encoding/json/jsontext"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -31,7 +32,7 @@ type User struct {
 	// URLs holds the value of the "URLs" field.
 	URLs []*url.URL `json:"urls,omitempty"`
 	// Raw holds the value of the "raw" field.
-	Raw json.RawMessage `json:"raw,omitempty"`
+	Raw jsontext.Value `json:"raw,omitempty"`
 	// Dirs holds the value of the "dirs" field.
 	Dirs []http.Dir `json:"dirs,omitempty"`
 	// Ints holds the value of the "ints" field.
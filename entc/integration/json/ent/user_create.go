@@ -8,12 +8,13 @@ package ent
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/json/ent/schema"
 	"entgo.io/ent/entc/integration/json/ent/user"
@@ -46,7 +47,7 @@ func (_c *UserCreate) SetURLs(v []*url.URL) *UserCreate {
 }
 
 // SetRaw sets the "raw" field.
-func (_c *UserCreate) SetRaw(v json.RawMessage) *UserCreate {
+func (_c *UserCreate) SetRaw(v jsontext.Value) *UserCreate {
 	_c.mutation.SetRaw(v)
 	return _c
 }
@@ -188,7 +189,7 @@ func (_c *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	_node, _spec := _c.createSpec()
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -249,7 +250,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.StringsValidate = value
 	}
 	if value, ok := _c.mutation.Addr(); ok {
-		_spec.SetField(user.FieldAddr, field.TypeJSON, value)
+		_spec.SetField(user.FieldAddr, field.TypeJSON, dialect.Sensitive{V: value})
 		_node.Addr = value
 	}
 	if value, ok := _c.mutation.Unknown(); ok {
@@ -292,11 +293,11 @@ func (_c *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs, BatchSize: _c.batchSize}
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -274,6 +274,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -614,5 +622,107 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	car.Table: {
+		label: car.Label,
+		fields: map[string]string{
+			car.FieldID: "id",
+		},
+	},
+	conversion.Table: {
+		label: conversion.Label,
+		fields: map[string]string{
+			conversion.FieldID:             "id",
+			conversion.FieldName:           "name",
+			conversion.FieldInt8ToString:   "int8_to_string",
+			conversion.FieldUint8ToString:  "uint8_to_string",
+			conversion.FieldInt16ToString:  "int16_to_string",
+			conversion.FieldUint16ToString: "uint16_to_string",
+			conversion.FieldInt32ToString:  "int32_to_string",
+			conversion.FieldUint32ToString: "uint32_to_string",
+			conversion.FieldInt64ToString:  "int64_to_string",
+			conversion.FieldUint64ToString: "uint64_to_string",
+		},
+	},
+	customtype.Table: {
+		label: customtype.Label,
+		fields: map[string]string{
+			customtype.FieldID:     "id",
+			customtype.FieldCustom: "custom",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:           "id",
+			user.FieldAge:          "age",
+			user.FieldName:         "name",
+			user.FieldDescription:  "description",
+			user.FieldNickname:     "nickname",
+			user.FieldAddress:      "address",
+			user.FieldRenamed:      "renamed",
+			user.FieldOldToken:     "old_token",
+			user.FieldBlob:         "blob",
+			user.FieldState:        "state",
+			user.FieldStatus:       "status",
+			user.FieldWorkplace:    "workplace",
+			user.FieldDropOptional: "drop_optional",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"Car_user_car_key":      {table: "Car", columns: []string{"user_car"}},
+	"Car_users_car":         {table: "Car", columns: []string{"user_car"}},
+	"users_nickname_key":    {table: "users", columns: []string{"nickname"}},
+	"users_user_spouse_key": {table: "users", columns: []string{"user_spouse"}},
+	"user_name_address":     {table: "users", columns: []string{"name", "address"}},
+	"users_users_children":  {table: "users", columns: []string{"user_children"}},
+	"users_users_spouse":    {table: "users", columns: []string{"user_spouse"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
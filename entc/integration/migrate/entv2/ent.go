@@ -284,6 +284,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -624,5 +632,157 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	blog.Table: {
+		label: blog.Label,
+		fields: map[string]string{
+			blog.FieldID:  "id",
+			blog.FieldOid: "oid",
+		},
+	},
+	car.Table: {
+		label: car.Label,
+		fields: map[string]string{
+			car.FieldID:   "id",
+			car.FieldName: "name",
+		},
+	},
+	conversion.Table: {
+		label: conversion.Label,
+		fields: map[string]string{
+			conversion.FieldID:             "id",
+			conversion.FieldName:           "name",
+			conversion.FieldInt8ToString:   "int8_to_string",
+			conversion.FieldUint8ToString:  "uint8_to_string",
+			conversion.FieldInt16ToString:  "int16_to_string",
+			conversion.FieldUint16ToString: "uint16_to_string",
+			conversion.FieldInt32ToString:  "int32_to_string",
+			conversion.FieldUint32ToString: "uint32_to_string",
+			conversion.FieldInt64ToString:  "int64_to_string",
+			conversion.FieldUint64ToString: "uint64_to_string",
+		},
+	},
+	customtype.Table: {
+		label: customtype.Label,
+		fields: map[string]string{
+			customtype.FieldID:     "id",
+			customtype.FieldCustom: "custom",
+			customtype.FieldTz0:    "tz0",
+			customtype.FieldTz3:    "tz3",
+		},
+	},
+	group.Table: {
+		label: group.Label,
+		fields: map[string]string{
+			group.FieldID: "id",
+		},
+	},
+	media.Table: {
+		label: media.Label,
+		fields: map[string]string{
+			media.FieldID:        "id",
+			media.FieldSource:    "source",
+			media.FieldSourceURI: "source_uri",
+			media.FieldText:      "text",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID:   "id",
+			pet.FieldName: "name",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:           "id",
+			user.FieldMixedString:  "mixed_string",
+			user.FieldMixedEnum:    "mixed_enum",
+			user.FieldActive:       "active",
+			user.FieldAge:          "age",
+			user.FieldName:         "name",
+			user.FieldDescription:  "description",
+			user.FieldNickname:     "nickname",
+			user.FieldPhone:        "phone",
+			user.FieldBuffer:       "buffer",
+			user.FieldTitle:        "title",
+			user.FieldNewName:      "new_name",
+			user.FieldNewToken:     "new_token",
+			user.FieldBlob:         "blob",
+			user.FieldState:        "state",
+			user.FieldStatus:       "status",
+			user.FieldWorkplace:    "workplace",
+			user.FieldRoles:        "roles",
+			user.FieldDefaultExpr:  "default_expr",
+			user.FieldDefaultExprs: "default_exprs",
+			user.FieldCreatedAt:    "created_at",
+			user.FieldDropOptional: "drop_optional",
+		},
+	},
+	zoo.Table: {
+		label: zoo.Label,
+		fields: map[string]string{
+			zoo.FieldID: "id",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"Car_name_key":            {table: "Car", columns: []string{"name"}},
+	"Car_users_car":           {table: "Car", columns: []string{"user_car"}},
+	"media_source_source_uri": {table: "media", columns: []string{"source", "source_uri"}},
+	"pets_name_key":           {table: "pets", columns: []string{"name"}},
+	"pets_owner_id_key":       {table: "pets", columns: []string{"owner_id"}},
+	"user_pet_id":             {table: "pets", columns: []string{"owner_id"}},
+	"user_phone_age":          {table: "users", columns: []string{"phone", "age"}},
+	"users_blogs_admins":      {table: "users", columns: []string{"blog_admins"}},
+	"user_friend_id1":         {table: "friends", columns: []string{"user"}},
+	"user_friend_id2":         {table: "friends", columns: []string{"friend"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
@@ -274,6 +274,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -614,5 +622,94 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	friendship.Table: {
+		label: friendship.Label,
+		fields: map[string]string{
+			friendship.FieldID:        "id",
+			friendship.FieldWeight:    "weight",
+			friendship.FieldCreatedAt: "created_at",
+			friendship.FieldUserID:    "user_id",
+			friendship.FieldFriendID:  "friend_id",
+		},
+	},
+	group.Table: {
+		label: group.Label,
+		fields: map[string]string{
+			group.FieldID:   "id",
+			group.FieldName: "name",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID:      "id",
+			pet.FieldName:    "name",
+			pet.FieldOwnerID: "owner_id",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:   "id",
+			user.FieldName: "name",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"friendship_user_id_friend_id": {table: "friendships", columns: []string{"user_id", "friend_id"}},
+	"friendships_users_user":       {table: "friendships", columns: []string{"user_id"}},
+	"friendships_users_friend":     {table: "friendships", columns: []string{"friend_id"}},
+	"pets_users_pets":              {table: "pets", columns: []string{"owner_id"}},
+	"group_users_group_id":         {table: "group_users", columns: []string{"group_id"}},
+	"group_users_user_id":          {table: "group_users", columns: []string{"user_id"}},
+	"user_following_user_id":       {table: "user_following", columns: []string{"user_id"}},
+	"user_following_follower_id":   {table: "user_following", columns: []string{"follower_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
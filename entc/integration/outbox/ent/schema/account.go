@@ -0,0 +1,43 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// keyring is used by the tests to encrypt fields.
+var keyring = field.NewAESKeyring(
+	[]byte("0123456789abcdef0123456789abcdef"),
+	field.AESKey{ID: "v1", Key: []byte("0123456789abcdef")},
+)
+
+// Account holds the schema definition for the Account entity.
+type Account struct {
+	ent.Schema
+}
+
+// Annotations of the Account.
+func (Account) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Outbox(),
+	}
+}
+
+// Fields of the Account.
+func (Account) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("token").
+			Optional().
+			Sensitive(),
+		field.String("ssn").
+			Optional().
+			Encrypted(keyring),
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/outbox/ent"
+	"entgo.io/ent/entc/integration/outbox/ent/account"
+	"entgo.io/ent/entc/integration/outbox/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonTransactional(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:non-tx?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a := client.Account.Create().SetName("a").SaveX(ctx)
+	client.Account.CreateBulk(
+		client.Account.Create().SetName("b"),
+		client.Account.Create().SetName("c"),
+	).ExecX(ctx)
+	// Returned entities are not bound to the internal transaction.
+	a = a.Update().SetName("a2").SaveX(ctx)
+	require.Equal(t, "a2", a.Name)
+
+	var ops []string
+	n, err := client.OutboxRelay(ent.OutboxPublisherFunc(func(_ context.Context, events []*ent.OutboxEvent) error {
+		for _, e := range events {
+			ops = append(ops, e.Op)
+		}
+		return nil
+	})).Drain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	require.Equal(t, []string{"OpCreate", "OpCreate", "OpCreate", "OpUpdateOne"}, ops)
+
+	// Mutations are rolled back in case their events cannot be written.
+	_, err = drv.DB().ExecContext(ctx, "DROP TABLE "+ent.OutboxTable)
+	require.NoError(t, err)
+	require.Error(t, client.Account.Create().SetName("d").Exec(ctx))
+	require.Error(t, client.Account.CreateBulk(
+		client.Account.Create().SetName("e"),
+		client.Account.Create().SetName("f"),
+	).Exec(ctx))
+	require.Error(t, a.Update().SetName("a3").Exec(ctx))
+	_, err = client.Account.Delete().Exec(ctx)
+	require.Error(t, err)
+	names := client.Account.Query().Order(account.ByID()).Select(account.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a2", "b", "c"}, names)
+}
+
+func TestSavepoint(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:savepoint?mode=memory&_fk=1")
+	defer client.Close()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	a := tx.Account.Create().SetName("a").SaveX(ctx)
+	sp1, err := tx.Tx(ctx)
+	require.NoError(t, err)
+	b := sp1.Account.Create().SetName("b").SaveX(ctx)
+	require.NoError(t, sp1.Commit())
+	sp2, err := tx.Tx(ctx)
+	require.NoError(t, err)
+	sp2.Account.Create().SetName("c").ExecX(ctx)
+	sp3, err := sp2.Tx(ctx)
+	require.NoError(t, err)
+	sp3.Account.Create().SetName("d").ExecX(ctx)
+	require.NoError(t, sp3.Commit())
+	require.NoError(t, sp2.Rollback())
+	e := tx.Account.Create().SetName("e").SaveX(ctx)
+	require.NoError(t, tx.Commit())
+
+	// Events of nested transactions are written in order by the root
+	// transaction, and events of rolled back savepoints are discarded.
+	var ids []string
+	_, err = client.OutboxRelay(ent.OutboxPublisherFunc(func(_ context.Context, events []*ent.OutboxEvent) error {
+		for _, e := range events {
+			ids = append(ids, e.NodeID)
+		}
+		return nil
+	})).Drain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{strconv.Itoa(a.ID), strconv.Itoa(b.ID), strconv.Itoa(e.ID)}, ids)
+	require.Equal(t, []string{"a", "b", "e"}, client.Account.Query().Order(account.ByID()).Select(account.FieldName).StringsX(ctx))
+}
+
+func TestEncryptedPayload(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:encrypted?mode=memory&_fk=1")
+	defer client.Close()
+	a := client.Account.Create().SetName("a").SetSsn("123-45-6789").SaveX(ctx)
+	require.Equal(t, "123-45-6789", a.Ssn)
+	a = a.Update().SetSsn("987-65-4321").SaveX(ctx)
+	require.Equal(t, "987-65-4321", a.Ssn)
+
+	var payloads []string
+	_, err := client.OutboxRelay(ent.OutboxPublisherFunc(func(_ context.Context, events []*ent.OutboxEvent) error {
+		for _, e := range events {
+			payloads = append(payloads, string(e.Payload))
+		}
+		return nil
+	})).Drain(ctx)
+	require.NoError(t, err)
+	require.Len(t, payloads, 2)
+	for _, p := range payloads {
+		require.Contains(t, p, `"name":"a"`)
+		require.NotContains(t, p, "ssn")
+		require.NotContains(t, p, "123-45-6789")
+		require.NotContains(t, p, "987-65-4321")
+	}
+}
+
+func TestSensitiveRedaction(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, "file:redaction?mode=memory&_fk=1")
+	require.NoError(t, err)
+	var logs []string
+	client := ent.NewClient(ent.Driver(dialect.DebugWithContext(drv, func(_ context.Context, v ...any) {
+		logs = append(logs, fmt.Sprint(v...))
+	})))
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a := client.Account.Create().SetName("a").SetToken("t0k3n").SaveX(ctx)
+	a.Update().SetName("b").ExecX(ctx)
+	var inserts int
+	for _, l := range logs {
+		require.NotContains(t, l, "t0k3n")
+		if strings.Contains(l, "INSERT INTO `"+ent.OutboxTable+"`") {
+			inserts++
+			// Payloads of nodes with sensitive fields are redacted.
+			require.Contains(t, l, "<sensitive>")
+			require.NotContains(t, l, `"name":`)
+		}
+	}
+	require.Equal(t, 2, inserts)
+}
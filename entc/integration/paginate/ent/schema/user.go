@@ -0,0 +1,37 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+// keyring is used by the tests to encrypt fields.
+var keyring = field.NewAESKeyring(
+	[]byte("0123456789abcdef0123456789abcdef"),
+	field.AESKey{ID: "v1", Key: []byte("0123456789abcdef")},
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("password").
+			Sensitive(),
+		field.String("ssn").
+			Encrypted(keyring),
+		field.Int("name_length").
+			Computed(func(s *sql.Selector) sql.Querier {
+				return sql.Expr("LENGTH(" + s.C("name") + ")")
+			}),
+	}
+}
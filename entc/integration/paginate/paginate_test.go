@@ -0,0 +1,48 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package paginate
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/paginate/ent/enttest"
+	"entgo.io/ent/entc/integration/paginate/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	for _, name := range []string{"c", "a", "b"} {
+		client.User.Create().SetName(name).SetPassword("pass-" + name).SetSsn("ssn-" + name).ExecX(ctx)
+	}
+	page, err := client.User.Query().Page(ctx, nil, 2, sql.OrderByField(user.FieldName))
+	require.NoError(t, err)
+	require.True(t, page.PageInfo.HasNextPage)
+	require.Equal(t, []string{"a", "b"}, []string{page.Nodes[0].Name, page.Nodes[1].Name})
+	page, err = client.User.Query().Page(ctx, page.PageInfo.EndCursor, 2, sql.OrderByField(user.FieldName))
+	require.NoError(t, err)
+	require.False(t, page.PageInfo.HasNextPage)
+	require.Len(t, page.Nodes, 1)
+	require.Equal(t, "c", page.Nodes[0].Name)
+}
+
+func TestCursorFields(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	client.User.Create().SetName("a").SetPassword("secret").SetSsn("123-45-6789").ExecX(ctx)
+	// Sensitive, encrypted and computed fields must not be encoded in the cursors.
+	for _, f := range []string{user.FieldPassword, user.FieldSsn, user.FieldNameLength, "unknown"} {
+		_, err := client.User.Query().Page(ctx, nil, 10, sql.OrderByField(f))
+		require.ErrorContains(t, err, "ent: invalid field for pagination", f)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Category holds the schema definition for the Category entity.
+type Category struct {
+	ent.Schema
+}
+
+// Mixin of the Category.
+func (Category) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.SoftDelete{},
+	}
+}
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Category.Type).
+			From("parent").
+			Unique(),
+	}
+}
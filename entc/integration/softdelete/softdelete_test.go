@@ -0,0 +1,81 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package softdelete
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/entc/integration/softdelete/ent"
+	"entgo.io/ent/entc/integration/softdelete/ent/category"
+	"entgo.io/ent/entc/integration/softdelete/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecursiveTraversal(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	// root -> a -> b -> c, and root -> d.
+	root := client.Category.Create().SetName("root").SaveX(ctx)
+	a := client.Category.Create().SetName("a").SetParent(root).SaveX(ctx)
+	b := client.Category.Create().SetName("b").SetParent(a).SaveX(ctx)
+	client.Category.Create().SetName("c").SetParent(b).SaveX(ctx)
+	client.Category.Create().SetName("d").SetParent(root).SaveX(ctx)
+	names := func(ctx context.Context, q *ent.CategoryQuery) []string {
+		return q.Order(ent.Asc(category.FieldName)).Select(category.FieldName).StringsX(ctx)
+	}
+	require.Equal(t, []string{"a", "b", "c", "d"}, names(ctx, root.QueryChildren().Recursive(0)))
+
+	// Soft-deleted categories are not traversed, and their descendants are not reachable.
+	client.Category.DeleteOne(a).ExecX(ctx)
+	require.Equal(t, []string{"d"}, names(ctx, root.QueryChildren().Recursive(0)))
+	require.Equal(t, []string{"d"}, names(ctx, client.Category.Query().Where(category.Name("root")).QueryChildren().Recursive(0)))
+	require.Equal(t, []string{"d"}, names(ctx, root.QueryChildren().Recursive(2).RecursiveDepth("depth")))
+	require.Equal(t, []string{"b"}, names(ctx, client.Category.Query().Where(category.Name("c")).QueryParent().Recursive(0)))
+
+	// Skipping the soft-delete behavior traverses all categories.
+	skip := ent.SkipSoftDelete(ctx)
+	require.Equal(t, []string{"a", "b", "c", "d"}, names(skip, root.QueryChildren().Recursive(0)))
+	require.Equal(t, []string{"a", "b", "root"}, names(skip, client.Category.Query().Where(category.Name("c")).QueryParent().Recursive(0)))
+
+	// The same query can be executed with and without the soft-delete behavior.
+	q := root.QueryChildren().Recursive(0)
+	require.Equal(t, 4, q.CountX(skip))
+	require.Equal(t, 1, q.CountX(ctx))
+	require.Equal(t, 4, q.CountX(skip))
+}
+
+func TestPath(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:path?mode=memory&_fk=1")
+	defer client.Close()
+	// a -> b -> c.
+	a := client.Category.Create().SetName("a").SaveX(ctx)
+	b := client.Category.Create().SetName("b").SetParent(a).SaveX(ctx)
+	c := client.Category.Create().SetName("c").SetParent(b).SaveX(ctx)
+	path, err := client.Category.Path(ctx, a.ID, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, []int{a.ID, b.ID, c.ID}, path)
+
+	// Soft-deleted categories are not traversed.
+	client.Category.DeleteOne(b).ExecX(ctx)
+	_, err = client.Category.Path(ctx, a.ID, c.ID)
+	require.True(t, ent.IsNotFound(err))
+	ok, err := client.Category.Reachable(ctx, c.ID, a.ID, category.EdgeParent)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = client.Category.Reachable(ctx, b.ID, b.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	skip := ent.SkipSoftDelete(ctx)
+	path, err = client.Category.Path(skip, c.ID, a.ID, category.EdgeParent)
+	require.NoError(t, err)
+	require.Equal(t, []int{c.ID, b.ID, a.ID}, path)
+}
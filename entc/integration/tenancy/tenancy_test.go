@@ -0,0 +1,89 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package tenancy
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/entc/integration/tenancy/ent"
+	"entgo.io/ent/entc/integration/tenancy/ent/enttest"
+	"entgo.io/ent/entc/integration/tenancy/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossTenantPath(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	var (
+		skip = ent.SkipTenant(context.Background())
+		t1   = ent.WithTenant(context.Background(), 1)
+		t2   = ent.WithTenant(context.Background(), 2)
+	)
+	a := client.User.Create().SetName("a").SaveX(t1)
+	b := client.User.Create().SetName("b").SaveX(t1)
+	c := client.User.Create().SetName("c").SaveX(t2)
+	// The users of tenant 1 are connected only through the user of tenant 2.
+	client.User.UpdateOne(a).AddFriends(c).ExecX(skip)
+	client.User.UpdateOne(c).AddFriends(b).ExecX(skip)
+
+	path, err := client.User.Path(skip, a.ID, b.ID)
+	require.NoError(t, err)
+	require.Equal(t, []int{a.ID, c.ID, b.ID}, path)
+	ok, err := client.User.Reachable(skip, a.ID, b.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Users of other tenants are not traversed.
+	_, err = client.User.Path(t1, a.ID, b.ID)
+	require.True(t, ent.IsNotFound(err))
+	ok, err = client.User.Reachable(t1, a.ID, b.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = client.User.Reachable(t1, a.ID, c.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = client.User.Reachable(t2, c.ID, c.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, err = client.User.Path(t2, a.ID, a.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	// Path queries require a tenant in the context.
+	_, err = client.User.Reachable(context.Background(), a.ID, b.ID)
+	require.ErrorIs(t, err, ent.ErrMissingTenant)
+}
+
+func TestCrossTenantRecursive(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	var (
+		skip = ent.SkipTenant(context.Background())
+		t1   = ent.WithTenant(context.Background(), 1)
+		t2   = ent.WithTenant(context.Background(), 2)
+	)
+	a := client.User.Create().SetName("a").SaveX(t1)
+	b := client.User.Create().SetName("b").SaveX(t1)
+	c := client.User.Create().SetName("c").SaveX(t2)
+	d := client.User.Create().SetName("d").SaveX(t1)
+	// The user of tenant 2 connects the users of tenant 1. Note
+	// that friendship is bidirectional, and a is reachable from b.
+	client.User.UpdateOne(a).AddFriends(b, c).ExecX(skip)
+	client.User.UpdateOne(c).AddFriends(d).ExecX(skip)
+
+	ids := client.User.Query().Where(user.ID(a.ID)).QueryFriends().Recursive(0).IDsX(skip)
+	require.ElementsMatch(t, []int{a.ID, b.ID, c.ID, d.ID}, ids)
+
+	// Users of other tenants are not traversed.
+	ids = client.User.Query().Where(user.ID(a.ID)).QueryFriends().Recursive(0).IDsX(t1)
+	require.ElementsMatch(t, []int{a.ID, b.ID}, ids)
+	ids = client.User.Query().Where(user.ID(c.ID)).QueryFriends().Recursive(0).IDsX(t1)
+	require.Empty(t, ids)
+	ids = client.User.Query().Where(user.ID(c.ID)).QueryFriends().Recursive(0).IDsX(t2)
+	require.Empty(t, ids)
+}
@@ -58,17 +58,24 @@ type Field struct {
 	StorageKey       string                  `json:"storage_key,omitempty"`
 	Position         *Position               `json:"position,omitempty"`
 	Sensitive        bool                    `json:"sensitive,omitempty"`
+	Version          bool                    `json:"version,omitempty"`
 	SchemaType       map[string]string       `json:"schema_type,omitempty"`
 	Annotations      map[string]any          `json:"annotations,omitempty"`
 	Comment          string                  `json:"comment,omitempty"`
 	Deprecated       bool                    `json:"deprecated,omitempty"`
 	DeprecatedReason string                  `json:"deprecated_reason,omitempty"`
+	Embedded         []*Field                `json:"embedded,omitempty"`
+	Generated        *field.Generated        `json:"generated,omitempty"`
+	Computed         bool                    `json:"computed,omitempty"`
+	Encrypted        *field.Encryption       `json:"encrypted,omitempty"`
+	Array            bool                    `json:"array,omitempty"`
 }
 
 // Edge represents an ent.Edge that was loaded from a complied user package.
 type Edge struct {
 	Name        string                 `json:"name,omitempty"`
 	Type        string                 `json:"type,omitempty"`
+	Types       []string               `json:"types,omitempty"`
 	Tag         string                 `json:"tag,omitempty"`
 	Field       string                 `json:"field,omitempty"`
 	RefName     string                 `json:"ref_name,omitempty"`
@@ -97,6 +104,7 @@ func NewEdge(ed *edge.Descriptor) *Edge {
 	ne := &Edge{
 		Tag:         ed.Tag,
 		Type:        ed.Type,
+		Types:       ed.Types,
 		Name:        ed.Name,
 		Field:       ed.Field,
 		Unique:      ed.Unique,
@@ -139,11 +147,16 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 		StorageKey:       fd.StorageKey,
 		Validators:       len(fd.Validators),
 		Sensitive:        fd.Sensitive,
+		Version:          fd.Version,
 		SchemaType:       fd.SchemaType,
 		Annotations:      make(map[string]any),
 		Comment:          fd.Comment,
 		Deprecated:       fd.Deprecated,
 		DeprecatedReason: fd.DeprecatedReason,
+		Generated:        fd.Generated,
+		Computed:         fd.Computed != nil,
+		Encrypted:        fd.Encrypted,
+		Array:            fd.Array,
 	}
 	for _, at := range fd.Annotations {
 		sf.addAnnotation(at)
@@ -162,6 +175,13 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 	if _, err := json.Marshal(fd.Default); err == nil {
 		sf.DefaultValue = fd.Default
 	}
+	for _, md := range fd.Embedded {
+		mf, err := NewField(md)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", fd.Name, err)
+		}
+		sf.Embedded = append(sf.Embedded, mf)
+	}
 	return sf, nil
 }
 
@@ -278,6 +278,14 @@ func IsNotLoaded(err error) bool {
 type ConstraintError struct {
 	msg  string
 	wrap error
+	// Kind holds the kind of the violated constraint.
+	Kind sqlgraph.ConstraintKind
+	// Constraint holds the name of the violated constraint or index, if it is known.
+	Constraint string
+	// Label holds the label of the type that the constraint belongs to, if it is known.
+	Label string
+	// Fields holds the names of the fields of the violated constraint, if they are known.
+	Fields []string
 }
 
 // Error implements the error interface.
@@ -618,5 +626,128 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError for the given database error, that
+// holds the information about the violated constraint that was parsed from it.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	ce := sqlgraph.ParseConstraintError(err)
+	if ce == nil {
+		return e
+	}
+	e.Kind, e.Constraint = ce.Kind, ce.Name
+	table, columns := ce.Table, ce.Columns
+	if c, ok := constraintNames[ce.Name]; ok && (table == "" || table == c.table) {
+		table = c.table
+		if len(columns) == 0 {
+			columns = c.columns
+		}
+	}
+	t, ok := constraintTables[table]
+	if !ok {
+		return e
+	}
+	// MySQL names the implicit indexes of unique columns after the columns.
+	if _, ok := t.fields[ce.Name]; ok && len(columns) == 0 && ce.Kind == sqlgraph.UniqueConstraint {
+		columns = []string{ce.Name}
+	}
+	e.Label = t.label
+	for _, c := range columns {
+		if f, ok := t.fields[c]; ok {
+			e.Fields = append(e.Fields, f)
+		}
+	}
+	return e
+}
+
+// constraintTables maps the tables of the types to their
+// labels, and their columns to the names of their fields.
+var constraintTables = map[string]struct {
+	label  string
+	fields map[string]string
+}{
+	card.Table: {
+		label: card.Label,
+		fields: map[string]string{
+			card.FieldID:         "id",
+			card.FieldType:       "type",
+			card.FieldNumberHash: "number_hash",
+			card.FieldCvvHash:    "cvv_hash",
+			card.FieldExpiresAt:  "expires_at",
+			card.FieldOwnerID:    "owner_id",
+		},
+	},
+	payment.Table: {
+		label: payment.Label,
+		fields: map[string]string{
+			payment.FieldID:          "id",
+			payment.FieldCardID:      "card_id",
+			payment.FieldAmount:      "amount",
+			payment.FieldCurrency:    "currency",
+			payment.FieldTime:        "time",
+			payment.FieldDescription: "description",
+			payment.FieldStatus:      "status",
+		},
+	},
+	pet.Table: {
+		label: pet.Label,
+		fields: map[string]string{
+			pet.FieldID:           "id",
+			pet.FieldName:         "name",
+			pet.FieldAge:          "age",
+			pet.FieldWeight:       "weight",
+			pet.FieldBestFriendID: "best_friend_id",
+			pet.FieldOwnerID:      "owner_id",
+		},
+	},
+	session.Table: {
+		label: session.Label,
+		fields: map[string]string{
+			session.FieldID:        "id",
+			session.FieldActive:    "active",
+			session.FieldIssuedAt:  "issued_at",
+			session.FieldExpiresAt: "expires_at",
+			session.FieldToken:     "token",
+			session.FieldMethod:    "method",
+			session.FieldDeviceID:  "device_id",
+		},
+	},
+	sessiondevice.Table: {
+		label: sessiondevice.Label,
+		fields: map[string]string{
+			sessiondevice.FieldID:        "id",
+			sessiondevice.FieldIPAddress: "ip_address",
+			sessiondevice.FieldUserAgent: "user_agent",
+			sessiondevice.FieldLocation:  "location",
+			sessiondevice.FieldCreatedAt: "created_at",
+			sessiondevice.FieldUpdatedAt: "updated_at",
+		},
+	},
+	user.Table: {
+		label: user.Label,
+		fields: map[string]string{
+			user.FieldID:        "id",
+			user.FieldAge:       "age",
+			user.FieldFirstName: "first_name",
+			user.FieldLastName:  "last_name",
+			user.FieldTags:      "tags",
+		},
+	},
+}
+
+// constraintNames maps the names of the unique indexes and foreign keys
+// in the schema to their tables and columns.
+var constraintNames = map[string]struct {
+	table   string
+	columns []string
+}{
+	"cards_users_cards":                 {table: "cards", columns: []string{"owner_id"}},
+	"payments_cards_payments":           {table: "payments", columns: []string{"card_id"}},
+	"pets_best_friend_id_key":           {table: "pets", columns: []string{"best_friend_id"}},
+	"pet_name_owner_id":                 {table: "pets", columns: []string{"name", "owner_id"}},
+	"pets_pets_best_friend":             {table: "pets", columns: []string{"best_friend_id"}},
+	"pets_users_owner":                  {table: "pets", columns: []string{"owner_id"}},
+	"sessions_session_devices_sessions": {table: "sessions", columns: []string{"device_id"}},
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
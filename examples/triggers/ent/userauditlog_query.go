@@ -517,6 +517,9 @@ func (_s *UserAuditLogSelect) sqlScan(ctx context.Context, root *UserAuditLogQue
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
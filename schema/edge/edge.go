@@ -14,6 +14,7 @@ import (
 type Descriptor struct {
 	Tag         string                 // struct tag.
 	Type        string                 // edge type.
+	Types       []string               // edge types; polymorphic edges only.
 	Name        string                 // edge name.
 	Field       string                 // edge field name (e.g. foreign-key).
 	RefName     string                 // ref name; inverse only.
@@ -29,8 +30,22 @@ type Descriptor struct {
 }
 
 // To defines an association edge between two vertices.
-func To(name string, t any) *assocBuilder {
-	return &assocBuilder{desc: &Descriptor{Name: name, Type: typ(t)}}
+//
+// A unique edge can be defined with more than one type, to define a polymorphic edge that
+// points to one vertex of either types. For example, a comment that belongs to a post or
+// to a photo:
+//
+//	edge.To("target", Post.Type, Photo.Type).
+//		Unique()
+func To(name string, t any, ts ...any) *assocBuilder {
+	desc := &Descriptor{Name: name, Type: typ(t)}
+	if len(ts) > 0 {
+		desc.Types = append(desc.Types, desc.Type)
+		for _, t := range ts {
+			desc.Types = append(desc.Types, typ(t))
+		}
+	}
+	return &assocBuilder{desc: desc}
 }
 
 // From represents a reversed-edge between two vertices that has a back-reference to its source edge.
@@ -50,6 +50,17 @@ func TestEdge(t *testing.T) {
 	assert.Equal("comment", e.Comment)
 	assert.Empty(e.Ref.Field)
 
+	t.Log("polymorphic edge")
+	type Post struct{ ent.Schema }
+	type Photo struct{ ent.Schema }
+	e = edge.To("target", Post.Type, Photo.Type).
+		Unique().
+		Descriptor()
+	assert.Equal("Post", e.Type)
+	assert.Equal([]string{"Post", "Photo"}, e.Types)
+	assert.True(e.Unique)
+	assert.Empty(edge.To("parent", Node.Type).Descriptor().Types)
+
 	t.Log("m2m relation of the same type")
 	from := edge.To("following", User.Type).
 		From("followers").
@@ -32,8 +32,9 @@ type Annotation struct {
 	ID []string
 }
 
-// ID defines a multi-field schema identifier. Note, the
-// annotation is valid only for edge schemas.
+// ID defines a multi-field schema identifier. The annotation
+// is valid for edge schemas, and for types that are stored in
+// SQL databases with a multi-column primary key.
 //
 //	func (TweetLike) Annotations() []schema.Annotation {
 //		return []schema.Annotation{
@@ -0,0 +1,34 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import "entgo.io/ent/dialect/sql"
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+// The function receives the selector of the query, and can use it to qualify columns.
+//
+//	field.String("display_name").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			return sql.Expr(fmt.Sprintf("UPPER(%s)", s.C("name")))
+//		})
+func (b *stringBuilder) Computed(fn func(*sql.Selector) sql.Querier) *stringBuilder {
+	b.desc.Computed = fn
+	return b
+}
+
+// Computed marks the field as a computed field that is not stored in the database,
+// but evaluated by the given SQL expression whenever the field is selected.
+func (b *boolBuilder) Computed(fn func(*sql.Selector) sql.Querier) *boolBuilder {
+	b.desc.Computed = fn
+	return b
+}
+
+// Computed marks the field as a computed field that is not stored in the database,
+// but evaluated by the given SQL expression whenever the field is selected.
+func (b *timeBuilder) Computed(fn func(*sql.Selector) sql.Querier) *timeBuilder {
+	b.desc.Computed = fn
+	return b
+}
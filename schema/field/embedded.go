@@ -0,0 +1,139 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import (
+	"fmt"
+	"reflect"
+
+	"entgo.io/ent/schema"
+)
+
+// Embedded returns a new Field that flattens the given struct into multiple fields (and columns),
+// one for each of its exported members, that are prefixed with the field name. For example:
+//
+//	type Address struct {
+//		Street string
+//		City   string
+//	}
+//
+//	field.Embedded("address", Address{}).
+//		Optional()
+//
+// Defines the "address_street" and "address_city" fields in the schema, and an "Address" field
+// in the generated entity that holds both. Members can be strings, booleans, numbers or time.Time.
+func Embedded[T any](name string, typ T) *embeddedBuilder[T] {
+	b := &embeddedBuilder[T]{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: TypeJSON},
+	}}
+	t := reflect.TypeOf(typ)
+	if t == nil || t.Kind() != reflect.Struct {
+		b.desc.Err = fmt.Errorf("expect a struct type for embedded field, but got %v", t)
+		return b
+	}
+	b.desc.goType(typ)
+	for i := 0; i < t.NumField(); i++ {
+		m := t.Field(i)
+		if !m.IsExported() {
+			continue
+		}
+		d, err := embeddedMember(m)
+		if err != nil {
+			b.desc.Err = err
+			return b
+		}
+		b.desc.Embedded = append(b.desc.Embedded, d)
+	}
+	if len(b.desc.Embedded) == 0 {
+		b.desc.Err = fmt.Errorf("embedded struct %s has no exported members", t)
+	}
+	return b
+}
+
+// embeddedKinds maps the kinds of the supported embedded members to their field types.
+var embeddedKinds = map[reflect.Kind]Type{
+	reflect.Bool:    TypeBool,
+	reflect.String:  TypeString,
+	reflect.Int:     TypeInt,
+	reflect.Int8:    TypeInt8,
+	reflect.Int16:   TypeInt16,
+	reflect.Int32:   TypeInt32,
+	reflect.Int64:   TypeInt64,
+	reflect.Uint:    TypeUint,
+	reflect.Uint8:   TypeUint8,
+	reflect.Uint16:  TypeUint16,
+	reflect.Uint32:  TypeUint32,
+	reflect.Uint64:  TypeUint64,
+	reflect.Float32: TypeFloat32,
+	reflect.Float64: TypeFloat64,
+}
+
+// embeddedMember returns the descriptor of the given struct member. Its name is
+// the name of the struct member, and it is prefixed by the codegen.
+func embeddedMember(m reflect.StructField) (*Descriptor, error) {
+	if m.Type == timeType {
+		return &Descriptor{Name: m.Name, Info: &TypeInfo{Type: TypeTime, PkgPath: "time"}}, nil
+	}
+	t, ok := embeddedKinds[m.Type.Kind()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %s for member %s of embedded struct", m.Type, m.Name)
+	}
+	d := &Descriptor{Name: m.Name, Info: &TypeInfo{Type: t}}
+	// Named types (e.g. enum-like strings) are defined as GoTypes.
+	if m.Type.PkgPath() != "" {
+		d.goType(reflect.Zero(m.Type).Interface())
+	}
+	return d, nil
+}
+
+// embeddedBuilder is the builder for embedded fields.
+type embeddedBuilder[T any] struct {
+	desc *Descriptor
+}
+
+// Optional indicates that this field is optional on create. It applies
+// to all members of the struct, and their columns are nullable.
+func (b *embeddedBuilder[T]) Optional() *embeddedBuilder[T] {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field (and all members of the struct) cannot be updated.
+func (b *embeddedBuilder[T]) Immutable() *embeddedBuilder[T] {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *embeddedBuilder[T]) Comment(c string) *embeddedBuilder[T] {
+	b.desc.Comment = c
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *embeddedBuilder[T]) StructTag(s string) *embeddedBuilder[T] {
+	b.desc.Tag = s
+	return b
+}
+
+// Validate adds a validator for this field. Operation fails if the validation fails.
+// The validator is called with the whole struct, when all of its members are set.
+func (b *embeddedBuilder[T]) Validate(fn func(T) error) *embeddedBuilder[T] {
+	b.desc.Validators = append(b.desc.Validators, fn)
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *embeddedBuilder[T]) Annotations(annotations ...schema.Annotation) *embeddedBuilder[T] {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *embeddedBuilder[T]) Descriptor() *Descriptor {
+	return b.desc
+}
@@ -0,0 +1,276 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Encrypted configures the field to be encrypted on write and decrypted on scan, using the given
+// Keyring. Encrypted values are passed to the database driver as ciphertext, and therefore, they
+// are never logged in plaintext. String values are stored as base64-encoded ciphertext.
+//
+//	field.String("ssn").
+//		Encrypted(field.NewAESKeyring(indexKey, field.AESKey{ID: "v1", Key: key})).
+//		BlindIndex()
+//
+// Note that encrypted fields cannot be compared in the database. Use the BlindIndex option in order
+// to support equality predicates.
+func (b *stringBuilder) Encrypted(kr Keyring) *stringBuilder {
+	b.desc.encrypted(encryptedValueScanner[string]{kr: kr})
+	return b
+}
+
+// BlindIndex adds a companion column to the encrypted field that holds the HMAC of its value
+// (a "blind index"). The column is updated along with the field, and it is used by the EQ,
+// NEQ, In and NotIn predicates of the field. Unique encrypted fields must have a blind index.
+func (b *stringBuilder) BlindIndex() *stringBuilder {
+	b.desc.blindIndex()
+	return b
+}
+
+// Encrypted configures the field to be encrypted on write and decrypted on scan, using the given
+// Keyring. Encrypted values are passed to the database driver as ciphertext, and therefore, they
+// are never logged in plaintext.
+func (b *bytesBuilder) Encrypted(kr Keyring) *bytesBuilder {
+	b.desc.encrypted(encryptedValueScanner[[]byte]{kr: kr})
+	return b
+}
+
+// BlindIndex adds a companion column to the encrypted field that holds the HMAC of its value
+// (a "blind index"). The column is updated along with the field, and it is used by the EQ,
+// NEQ, In and NotIn predicates of the field. Unique encrypted fields must have a blind index.
+func (b *bytesBuilder) BlindIndex() *bytesBuilder {
+	b.desc.blindIndex()
+	return b
+}
+
+// Encryption holds the encryption options of a field.
+type Encryption struct {
+	BlindIndex bool `json:"blind_index,omitempty"`
+}
+
+func (d *Descriptor) encrypted(vs any) {
+	if d.Encrypted == nil {
+		d.Encrypted = &Encryption{}
+	}
+	d.ValueScanner = vs
+}
+
+func (d *Descriptor) blindIndex() {
+	if d.Encrypted == nil {
+		d.Encrypted = &Encryption{}
+	}
+	d.Encrypted.BlindIndex = true
+}
+
+// checkEncrypted ensures the ValueScanner of the encrypted field was not overridden.
+func (d *Descriptor) checkEncrypted() {
+	switch d.ValueScanner.(type) {
+	case encryptedValueScanner[string], encryptedValueScanner[[]byte]:
+		if d.Info.RType != nil {
+			d.Err = fmt.Errorf("encrypted field %q cannot have a custom GoType", d.Name)
+		}
+	case nil:
+		d.Err = fmt.Errorf("blind index of field %q requires the field to be encrypted", d.Name)
+	default:
+		d.Err = fmt.Errorf("encrypted field %q cannot have a custom ValueScanner", d.Name)
+	}
+}
+
+// Keyring is the interface that wraps the methods used for encrypting
+// field values and computing their blind indexes. Implementations are
+// responsible for key rotation, and therefore, Decrypt should be able
+// to decrypt values that were encrypted by previous keys.
+type Keyring interface {
+	// Encrypt encrypts the given plaintext using the current key.
+	Encrypt(plaintext []byte) ([]byte, error)
+	// Decrypt decrypts the given ciphertext.
+	Decrypt(ciphertext []byte) ([]byte, error)
+	// BlindIndex returns a deterministic keyed hash of the given plaintext.
+	BlindIndex(plaintext []byte) ([]byte, error)
+}
+
+// AESKey is an encryption key of the AESKeyring.
+type AESKey struct {
+	ID  string // Key identifier that is stored with the ciphertext.
+	Key []byte // AES key. Either 16, 24, or 32 bytes.
+}
+
+// AESKeyring is the default Keyring. It encrypts values using AES-GCM, and computes
+// blind indexes using HMAC-SHA256.
+type AESKeyring struct {
+	index []byte
+	keys  []AESKey
+	once  sync.Once
+	aeads map[string]cipher.AEAD
+	err   error
+}
+
+// NewAESKeyring returns a new AESKeyring with the given index key and encryption keys.
+// The first key is the primary key, and it is used for encrypting new values. The rest
+// of the keys are used only for decrypting values that were encrypted before the keys
+// were rotated. Note that the index key cannot be rotated without recomputing the blind
+// indexes of the stored values.
+//
+// The keys are validated on first use, to allow loading schemas without the keys (e.g. on codegen).
+func NewAESKeyring(index []byte, keys ...AESKey) *AESKeyring {
+	return &AESKeyring{index: index, keys: keys}
+}
+
+func (k *AESKeyring) init() error {
+	k.once.Do(func() {
+		if len(k.keys) == 0 {
+			k.err = errors.New("field: AESKeyring requires at least one key")
+			return
+		}
+		k.aeads = make(map[string]cipher.AEAD, len(k.keys))
+		for _, key := range k.keys {
+			if len(key.ID) > 255 {
+				k.err = fmt.Errorf("field: AESKeyring key id %q is too long", key.ID)
+				return
+			}
+			if _, ok := k.aeads[key.ID]; ok {
+				k.err = fmt.Errorf("field: AESKeyring key id %q is not unique", key.ID)
+				return
+			}
+			block, err := aes.NewCipher(key.Key)
+			if err != nil {
+				k.err = fmt.Errorf("field: AESKeyring key %q: %w", key.ID, err)
+				return
+			}
+			if k.aeads[key.ID], err = cipher.NewGCM(block); err != nil {
+				k.err = fmt.Errorf("field: AESKeyring key %q: %w", key.ID, err)
+				return
+			}
+		}
+	})
+	return k.err
+}
+
+// Encrypt implements the Keyring interface. The returned ciphertext
+// is prefixed with the identifier of the key and the random nonce.
+func (k *AESKeyring) Encrypt(plaintext []byte) ([]byte, error) {
+	if err := k.init(); err != nil {
+		return nil, err
+	}
+	id := k.keys[0].ID
+	aead := k.aeads[id]
+	out := make([]byte, 1+len(id)+aead.NonceSize(), 1+len(id)+aead.NonceSize()+len(plaintext)+aead.Overhead())
+	out[0] = byte(len(id))
+	copy(out[1:], id)
+	nonce := out[1+len(id):]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(out, nonce, plaintext, nil), nil
+}
+
+// Decrypt implements the Keyring interface.
+func (k *AESKeyring) Decrypt(ciphertext []byte) ([]byte, error) {
+	if err := k.init(); err != nil {
+		return nil, err
+	}
+	if len(ciphertext) == 0 || len(ciphertext) < 1+int(ciphertext[0]) {
+		return nil, errors.New("field: malformed ciphertext")
+	}
+	id := string(ciphertext[1 : 1+ciphertext[0]])
+	aead, ok := k.aeads[id]
+	if !ok {
+		return nil, fmt.Errorf("field: unknown encryption key %q", id)
+	}
+	ciphertext = ciphertext[1+len(id):]
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("field: malformed ciphertext")
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+}
+
+// BlindIndex implements the Keyring interface.
+func (k *AESKeyring) BlindIndex(plaintext []byte) ([]byte, error) {
+	if len(k.index) == 0 {
+		return nil, errors.New("field: AESKeyring requires an index key for computing blind indexes")
+	}
+	h := hmac.New(sha256.New, k.index)
+	h.Write(plaintext)
+	return h.Sum(nil), nil
+}
+
+// BlindIndex returns the blind index of the given value using the ValueScanner of an encrypted field.
+// It is used by the generated code for storing and querying the blind index column of the field.
+func BlindIndex[T string | []byte](vs TypeValueScanner[T], v T) (string, error) {
+	ev, ok := vs.(encryptedValueScanner[T])
+	if !ok {
+		return "", fmt.Errorf("field: unexpected ValueScanner for encrypted field: %T", vs)
+	}
+	idx, err := ev.kr.BlindIndex([]byte(v))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(idx), nil
+}
+
+// encryptedValueScanner is the TypeValueScanner of encrypted fields.
+type encryptedValueScanner[T string | []byte] struct {
+	kr Keyring
+}
+
+// Value implements the TypeValueScanner.Value method.
+func (e encryptedValueScanner[T]) Value(v T) (driver.Value, error) {
+	ct, err := e.kr.Encrypt([]byte(v))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := any(v).(string); ok {
+		return base64.StdEncoding.EncodeToString(ct), nil
+	}
+	return ct, nil
+}
+
+// ScanValue implements the TypeValueScanner.ScanValue method.
+func (e encryptedValueScanner[T]) ScanValue() ValueScanner {
+	var v T
+	if _, ok := any(v).(string); ok {
+		return &sql.NullString{}
+	}
+	return &sql.Null[[]byte]{}
+}
+
+// FromValue implements the TypeValueScanner.FromValue method.
+func (e encryptedValueScanner[T]) FromValue(v driver.Value) (tv T, err error) {
+	var ct []byte
+	switch v := v.(type) {
+	case *sql.NullString:
+		if !v.Valid {
+			return tv, nil
+		}
+		if ct, err = base64.StdEncoding.DecodeString(v.String); err != nil {
+			return tv, err
+		}
+	case *sql.Null[[]byte]:
+		if !v.Valid {
+			return tv, nil
+		}
+		ct = v.V
+	default:
+		return tv, fmt.Errorf("unexpected input for FromValue: %T", v)
+	}
+	pt, err := e.kr.Decrypt(ct)
+	if err != nil {
+		return tv, err
+	}
+	return T(pt), nil
+}
@@ -344,6 +344,18 @@ func (b *stringBuilder) SchemaType(types map[string]string) *stringBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed by the database
+// from the given expression. Stored columns are computed on write and occupy storage,
+// and virtual columns are computed on read. Generated fields are read-only, and their
+// values are read back from the database after the entity is created.
+//
+//	field.String("full_name").
+//		Generated("first_name || ' ' || last_name", true)
+func (b *stringBuilder) Generated(expr string, stored bool) *stringBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -394,6 +406,9 @@ func (b *stringBuilder) Descriptor() *Descriptor {
 		b.desc.checkDefaultFunc(stringType)
 	}
 	b.desc.checkGoType(stringType)
+	if b.desc.Encrypted != nil {
+		b.desc.checkEncrypted()
+	}
 	return b.desc
 }
 
@@ -531,6 +546,13 @@ func (b *timeBuilder) SchemaType(types map[string]string) *timeBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *timeBuilder) Generated(expr string, stored bool) *timeBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // boolBuilder is the builder for boolean fields.
 type boolBuilder struct {
 	desc *Descriptor
@@ -581,6 +603,13 @@ func (b *boolBuilder) StorageKey(key string) *boolBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *boolBuilder) Generated(expr string, stored bool) *boolBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -785,6 +814,13 @@ func (b *bytesBuilder) SchemaType(types map[string]string) *bytesBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *bytesBuilder) Generated(expr string, stored bool) *bytesBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // Deprecated marks the field as deprecated. Deprecated fields are not
 // selected by default in queries, and their struct fields are annotated
 // with `deprecated` in the generated code.
@@ -802,6 +838,9 @@ func (b *bytesBuilder) Descriptor() *Descriptor {
 		b.desc.checkDefaultFunc(bytesType)
 	}
 	b.desc.checkGoType(bytesType)
+	if b.desc.Encrypted != nil {
+		b.desc.checkEncrypted()
+	}
 	return b.desc
 }
 
@@ -861,6 +900,13 @@ func (b *jsonBuilder) SchemaType(types map[string]string) *jsonBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *jsonBuilder) Generated(expr string, stored bool) *jsonBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // Annotations adds a list of annotations to the field object to be used by
 // codegen extensions.
 func (b *jsonBuilder) Annotations(annotations ...schema.Annotation) *jsonBuilder {
@@ -985,6 +1031,17 @@ func (b *sliceBuilder[T]) Default(v []T) *sliceBuilder[T] {
 	return b
 }
 
+// Array configures the field to be stored in a native array column (e.g. text[]
+// or bigint[]) in PostgreSQL, instead of a JSON column. Other SQL dialects fall
+// back to the JSON representation.
+//
+//	field.Strings("tags").
+//		Array()
+func (b *sliceBuilder[T]) Array() *sliceBuilder[T] {
+	b.desc.Array = true
+	return b
+}
+
 // Deprecated marks the field as deprecated. Deprecated fields are not
 // selected by default in queries, and their struct fields are annotated
 // with `deprecated` in the generated code.
@@ -1123,6 +1180,13 @@ func (b *enumBuilder) SchemaType(types map[string]string) *enumBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *enumBuilder) Generated(expr string, stored bool) *enumBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // Annotations adds a list of annotations to the field object to be used by
 // codegen extensions.
 //
@@ -1254,6 +1318,13 @@ func (b *uuidBuilder) SchemaType(types map[string]string) *uuidBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *uuidBuilder) Generated(expr string, stored bool) *uuidBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // Annotations adds a list of annotations to the field object to be used by
 // codegen extensions.
 //
@@ -1381,6 +1452,13 @@ func (b *otherBuilder) SchemaType(types map[string]string) *otherBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *otherBuilder) Generated(expr string, stored bool) *otherBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
 // Annotations adds a list of annotations to the field object to be used by
 // codegen extensions.
 //
@@ -1417,6 +1495,12 @@ func (b *otherBuilder) Descriptor() *Descriptor {
 	return b.desc
 }
 
+// Generated holds the definition of a generated (computed) column.
+type Generated struct {
+	Expr   string `json:"expr,omitempty"`   // SQL expression.
+	Stored bool   `json:"stored,omitempty"` // STORED or VIRTUAL.
+}
+
 // A Descriptor for field configuration.
 type Descriptor struct {
 	Tag              string                  // struct tag.
@@ -1434,11 +1518,17 @@ type Descriptor struct {
 	StorageKey       string                  // sql column or gremlin property.
 	Enums            []struct{ N, V string } // enum values.
 	Sensitive        bool                    // sensitive info string field.
+	Version          bool                    // optimistic locking version field.
 	SchemaType       map[string]string       // override the schema type.
 	Annotations      []schema.Annotation     // field annotations.
 	Comment          string                  // field comment.
 	Deprecated       bool                    // mark the field as deprecated.
 	DeprecatedReason string                  // deprecation reason.
+	Embedded         []*Descriptor           // struct members; embedded fields only.
+	Generated        *Generated              // generated column expression.
+	Computed         any                     // computed field expression.
+	Encrypted        *Encryption             // encryption options.
+	Array            bool                    // native array column (PostgreSQL).
 	Err              error
 }
 
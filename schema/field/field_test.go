@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"net/http"
 	"net/url"
@@ -23,6 +24,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
 	"github.com/google/uuid"
@@ -99,6 +101,17 @@ func TestInt(t *testing.T) {
 	assert.Error(t, fd.Err)
 }
 
+func TestInt_Version(t *testing.T) {
+	fd := field.Int("version").Version().Descriptor()
+	assert.True(t, fd.Version)
+	assert.True(t, fd.Immutable)
+	assert.Equal(t, 1, fd.Default)
+
+	fd = field.Int64("version").Default(0).Version().Descriptor()
+	assert.True(t, fd.Version)
+	assert.Equal(t, int64(0), fd.Default)
+}
+
 func TestInt_DefaultFunc(t *testing.T) {
 	type CustomInt int
 
@@ -465,6 +478,16 @@ func TestSlices(t *testing.T) {
 	assert.Equal(t, []float64{}, fd.Default)
 	assert.Equal(t, "comment", fd.Comment)
 	assert.Len(t, fd.Validators, 1)
+	assert.False(t, fd.Array)
+
+	fd = field.Ints("ints").
+		Array().
+		Optional().
+		Descriptor()
+	assert.Equal(t, field.TypeJSON, fd.Info.Type)
+	assert.Equal(t, "[]int", fd.Info.String())
+	assert.True(t, fd.Array)
+	assert.True(t, fd.Optional)
 }
 
 type VString string
@@ -693,6 +716,152 @@ func TestJSON(t *testing.T) {
 	assert.EqualError(t, fd.Err, "expect a Go value as JSON type but got nil")
 }
 
+func TestEmbedded(t *testing.T) {
+	type Kind string
+	type Address struct {
+		Street  string
+		Zip     int
+		Kind    Kind
+		Updated time.Time
+		private bool
+	}
+	fd := field.Embedded("address", Address{}).
+		Optional().
+		Validate(func(Address) error { return nil }).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "address", fd.Name)
+	assert.True(t, fd.Optional)
+	assert.Len(t, fd.Validators, 1)
+	assert.Equal(t, "field_test.Address", fd.Info.Ident)
+	assert.Equal(t, "entgo.io/ent/schema/field_test", fd.Info.PkgPath)
+	require.Len(t, fd.Embedded, 4)
+	assert.Equal(t, "Street", fd.Embedded[0].Name)
+	assert.Equal(t, field.TypeString, fd.Embedded[0].Info.Type)
+	assert.Equal(t, field.TypeInt, fd.Embedded[1].Info.Type)
+	assert.Equal(t, field.TypeString, fd.Embedded[2].Info.Type)
+	assert.Equal(t, "field_test.Kind", fd.Embedded[2].Info.Ident)
+	assert.Equal(t, field.TypeTime, fd.Embedded[3].Info.Type)
+
+	fd = field.Embedded("address", &Address{}).Descriptor()
+	assert.EqualError(t, fd.Err, "expect a struct type for embedded field, but got *field_test.Address")
+	fd = field.Embedded("address", struct{ Tags []string }{}).Descriptor()
+	assert.EqualError(t, fd.Err, "unsupported type []string for member Tags of embedded struct")
+	fd = field.Embedded("address", struct{ s string }{}).Descriptor()
+	assert.EqualError(t, fd.Err, "embedded struct struct { s string } has no exported members")
+}
+
+func TestField_Generated(t *testing.T) {
+	fd := field.String("full_name").
+		Generated("first_name || ' ' || last_name", true).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, &field.Generated{Expr: "first_name || ' ' || last_name", Stored: true}, fd.Generated)
+	fd = field.Int("total").
+		Generated("price * quantity", false).
+		Descriptor()
+	assert.Equal(t, "price * quantity", fd.Generated.Expr)
+	assert.False(t, fd.Generated.Stored)
+	fd = field.Bool("active").Generated("deleted_at IS NULL", false).Descriptor()
+	assert.NotNil(t, fd.Generated)
+	fd = field.Time("expired_at").Descriptor()
+	assert.Nil(t, fd.Generated)
+}
+
+func TestField_Computed(t *testing.T) {
+	expr := func(s *entsql.Selector) entsql.Querier {
+		return entsql.Expr(fmt.Sprintf("LENGTH(%s)", s.C("name")))
+	}
+	fd := field.Int("name_len").
+		Computed(expr).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	fn, ok := fd.Computed.(func(*entsql.Selector) entsql.Querier)
+	require.True(t, ok)
+	s := entsql.Select().From(entsql.Table("users"))
+	query, _ := s.AppendSelectExprAs(fn(s), "name_len").Query()
+	assert.Equal(t, "SELECT (LENGTH(`users`.`name`)) AS `name_len` FROM `users`", query)
+	fd = field.String("upper").Computed(expr).Descriptor()
+	assert.NotNil(t, fd.Computed)
+	fd = field.Float("score").Descriptor()
+	assert.Nil(t, fd.Computed)
+}
+
+func TestField_Encrypted(t *testing.T) {
+	kr := field.NewAESKeyring([]byte("index"), field.AESKey{ID: "v1", Key: make([]byte, 32)})
+	fd := field.String("ssn").
+		Encrypted(kr).
+		BlindIndex().
+		Descriptor()
+	require.NoError(t, fd.Err)
+	assert.Equal(t, &field.Encryption{BlindIndex: true}, fd.Encrypted)
+	vs, ok := fd.ValueScanner.(field.TypeValueScanner[string])
+	require.True(t, ok)
+	v, err := vs.Value("123-45-6789")
+	require.NoError(t, err)
+	assert.NotContains(t, v, "123-45-6789")
+	sv := vs.ScanValue()
+	require.NoError(t, sv.Scan(v))
+	s, err := vs.FromValue(sv)
+	require.NoError(t, err)
+	assert.Equal(t, "123-45-6789", s)
+	idx1, err := field.BlindIndex(vs, "123-45-6789")
+	require.NoError(t, err)
+	idx2, err := field.BlindIndex(vs, "123-45-6789")
+	require.NoError(t, err)
+	assert.Equal(t, idx1, idx2)
+	assert.Len(t, idx1, 64)
+
+	fd = field.Bytes("secret").Encrypted(kr).Descriptor()
+	require.NoError(t, fd.Err)
+	assert.False(t, fd.Encrypted.BlindIndex)
+	bvs, ok := fd.ValueScanner.(field.TypeValueScanner[[]byte])
+	require.True(t, ok)
+	v, err = bvs.Value([]byte("secret"))
+	require.NoError(t, err)
+	sv = bvs.ScanValue()
+	require.NoError(t, sv.Scan(v))
+	b, err := bvs.FromValue(sv)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), b)
+
+	fd = field.String("ssn").BlindIndex().Descriptor()
+	assert.EqualError(t, fd.Err, `blind index of field "ssn" requires the field to be encrypted`)
+	fd = field.String("ssn").Encrypted(kr).ValueScanner(field.TextValueScanner[*big.Int]{}).Descriptor()
+	assert.EqualError(t, fd.Err, `encrypted field "ssn" cannot have a custom ValueScanner`)
+	fd = field.String("dir").GoType(http.Dir("dir")).Encrypted(kr).Descriptor()
+	assert.EqualError(t, fd.Err, `encrypted field "dir" cannot have a custom GoType`)
+}
+
+func TestAESKeyring(t *testing.T) {
+	k1, k2 := field.AESKey{ID: "v1", Key: make([]byte, 16)}, field.AESKey{ID: "v2", Key: make([]byte, 32)}
+	old := field.NewAESKeyring(nil, k1)
+	ct1, err := old.Encrypt([]byte("a8m"))
+	require.NoError(t, err)
+	ct2, err := old.Encrypt([]byte("a8m"))
+	require.NoError(t, err)
+	assert.NotEqual(t, ct1, ct2, "nonce should be random")
+	_, err = old.BlindIndex([]byte("a8m"))
+	assert.Error(t, err, "index key is required")
+
+	// Rotated keyring can decrypt values of previous keys.
+	kr := field.NewAESKeyring([]byte("index"), k2, k1)
+	pt, err := kr.Decrypt(ct1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a8m"), pt)
+	ct3, err := kr.Encrypt([]byte("a8m"))
+	require.NoError(t, err)
+	_, err = old.Decrypt(ct3)
+	assert.EqualError(t, err, `field: unknown encryption key "v2"`)
+	_, err = kr.Decrypt(ct3[:4])
+	assert.Error(t, err)
+
+	_, err = field.NewAESKeyring(nil).Encrypt(nil)
+	assert.Error(t, err)
+	_, err = field.NewAESKeyring(nil, field.AESKey{ID: "v1", Key: []byte("short")}).Encrypt(nil)
+	assert.Error(t, err)
+}
+
 func TestField_Tag(t *testing.T) {
 	fd := field.Bool("expired").
 		StructTag(`json:"expired,omitempty"`).
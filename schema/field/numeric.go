@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"strings"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema"
 )
 
@@ -221,6 +222,19 @@ func (b *intBuilder) Immutable() *intBuilder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *intBuilder) Version() *intBuilder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = int(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *intBuilder) StructTag(s string) *intBuilder {
 	b.desc.Tag = s
@@ -252,6 +266,28 @@ func (b *intBuilder) SchemaType(types map[string]string) *intBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *intBuilder) Generated(expr string, stored bool) *intBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *intBuilder) Computed(fn func(*sql.Selector) sql.Querier) *intBuilder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -409,6 +445,19 @@ func (b *uintBuilder) Immutable() *uintBuilder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *uintBuilder) Version() *uintBuilder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = uint(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uintBuilder) StructTag(s string) *uintBuilder {
 	b.desc.Tag = s
@@ -440,6 +489,28 @@ func (b *uintBuilder) SchemaType(types map[string]string) *uintBuilder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *uintBuilder) Generated(expr string, stored bool) *uintBuilder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *uintBuilder) Computed(fn func(*sql.Selector) sql.Querier) *uintBuilder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -607,6 +678,19 @@ func (b *int8Builder) Immutable() *int8Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *int8Builder) Version() *int8Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = int8(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int8Builder) StructTag(s string) *int8Builder {
 	b.desc.Tag = s
@@ -638,6 +722,28 @@ func (b *int8Builder) SchemaType(types map[string]string) *int8Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *int8Builder) Generated(expr string, stored bool) *int8Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *int8Builder) Computed(fn func(*sql.Selector) sql.Querier) *int8Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -805,6 +911,19 @@ func (b *int16Builder) Immutable() *int16Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *int16Builder) Version() *int16Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = int16(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int16Builder) StructTag(s string) *int16Builder {
 	b.desc.Tag = s
@@ -836,6 +955,28 @@ func (b *int16Builder) SchemaType(types map[string]string) *int16Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *int16Builder) Generated(expr string, stored bool) *int16Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *int16Builder) Computed(fn func(*sql.Selector) sql.Querier) *int16Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -1003,6 +1144,19 @@ func (b *int32Builder) Immutable() *int32Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *int32Builder) Version() *int32Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = int32(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int32Builder) StructTag(s string) *int32Builder {
 	b.desc.Tag = s
@@ -1034,6 +1188,28 @@ func (b *int32Builder) SchemaType(types map[string]string) *int32Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *int32Builder) Generated(expr string, stored bool) *int32Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *int32Builder) Computed(fn func(*sql.Selector) sql.Querier) *int32Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -1201,6 +1377,19 @@ func (b *int64Builder) Immutable() *int64Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *int64Builder) Version() *int64Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = int64(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int64Builder) StructTag(s string) *int64Builder {
 	b.desc.Tag = s
@@ -1232,6 +1421,28 @@ func (b *int64Builder) SchemaType(types map[string]string) *int64Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *int64Builder) Generated(expr string, stored bool) *int64Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *int64Builder) Computed(fn func(*sql.Selector) sql.Querier) *int64Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -1389,6 +1600,19 @@ func (b *uint8Builder) Immutable() *uint8Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *uint8Builder) Version() *uint8Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = uint8(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint8Builder) StructTag(s string) *uint8Builder {
 	b.desc.Tag = s
@@ -1420,6 +1644,28 @@ func (b *uint8Builder) SchemaType(types map[string]string) *uint8Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *uint8Builder) Generated(expr string, stored bool) *uint8Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *uint8Builder) Computed(fn func(*sql.Selector) sql.Querier) *uint8Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -1577,6 +1823,19 @@ func (b *uint16Builder) Immutable() *uint16Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *uint16Builder) Version() *uint16Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = uint16(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint16Builder) StructTag(s string) *uint16Builder {
 	b.desc.Tag = s
@@ -1608,6 +1867,28 @@ func (b *uint16Builder) SchemaType(types map[string]string) *uint16Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *uint16Builder) Generated(expr string, stored bool) *uint16Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *uint16Builder) Computed(fn func(*sql.Selector) sql.Querier) *uint16Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -1765,6 +2046,19 @@ func (b *uint32Builder) Immutable() *uint32Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *uint32Builder) Version() *uint32Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = uint32(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint32Builder) StructTag(s string) *uint32Builder {
 	b.desc.Tag = s
@@ -1796,6 +2090,28 @@ func (b *uint32Builder) SchemaType(types map[string]string) *uint32Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *uint32Builder) Generated(expr string, stored bool) *uint32Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *uint32Builder) Computed(fn func(*sql.Selector) sql.Querier) *uint32Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -1953,6 +2269,19 @@ func (b *uint64Builder) Immutable() *uint64Builder {
 	return b
 }
 
+// Version marks the field as the version of the entity that is used for optimistic
+// locking. The version is managed by ent and cannot be updated directly. Instead, it
+// is incremented by every update, and updating an entity that was loaded with an
+// outdated version fails. Unless a default value is set, the initial version is 1.
+func (b *uint64Builder) Version() *uint64Builder {
+	b.desc.Version = true
+	b.desc.Immutable = true
+	if b.desc.Default == nil {
+		b.desc.Default = uint64(1)
+	}
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint64Builder) StructTag(s string) *uint64Builder {
 	b.desc.Tag = s
@@ -1984,6 +2313,28 @@ func (b *uint64Builder) SchemaType(types map[string]string) *uint64Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *uint64Builder) Generated(expr string, stored bool) *uint64Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field. Computed fields are not stored in the
+// database, but evaluated by the given SQL expression whenever the field is selected.
+//
+//	field.Int("pets_count").
+//		Computed(func(s *sql.Selector) sql.Querier {
+//			t := sql.Table("pets")
+//			return sql.Select(sql.Count("*")).
+//				From(t).
+//				Where(sql.ColumnsEQ(t.C("owner_id"), s.C("id")))
+//		})
+func (b *uint64Builder) Computed(fn func(*sql.Selector) sql.Querier) *uint64Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -2191,6 +2542,20 @@ func (b *float64Builder) SchemaType(types map[string]string) *float64Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *float64Builder) Generated(expr string, stored bool) *float64Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field that is not stored in the database,
+// but evaluated by the given SQL expression whenever the field is selected.
+func (b *float64Builder) Computed(fn func(*sql.Selector) sql.Querier) *float64Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
@@ -2385,6 +2750,20 @@ func (b *float32Builder) SchemaType(types map[string]string) *float32Builder {
 	return b
 }
 
+// Generated marks the field as a generated column that is computed
+// by the database from the given expression (STORED or VIRTUAL).
+func (b *float32Builder) Generated(expr string, stored bool) *float32Builder {
+	b.desc.Generated = &Generated{Expr: expr, Stored: stored}
+	return b
+}
+
+// Computed marks the field as a computed field that is not stored in the database,
+// but evaluated by the given SQL expression whenever the field is selected.
+func (b *float32Builder) Computed(fn func(*sql.Selector) sql.Querier) *float32Builder {
+	b.desc.Computed = fn
+	return b
+}
+
 // GoType overrides the default Go type with a custom one.
 // If the provided type implements the Validator interface
 // and no validators have been set, the type validator will
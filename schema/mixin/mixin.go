@@ -88,6 +88,67 @@ func (Time) Fields() []ent.Field {
 // time mixin must implement `Mixin` interface.
 var _ ent.Mixin = (*Time)(nil)
 
+// SoftDelete adds the delete time field to the schema, and marks it as
+// soft-deletable. For soft-deletable types, the code generator turns Delete
+// and DeleteOne into updates that set the delete time, and excludes deleted
+// rows from all queries, edge traversals and eager-loading by default.
+type SoftDelete struct{ Schema }
+
+// Fields of the soft delete mixin.
+func (SoftDelete) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("delete_time").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Annotations of the soft delete mixin.
+func (SoftDelete) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		SoftDeleteAnnotation{Field: "delete_time"},
+	}
+}
+
+// soft delete mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*SoftDelete)(nil)
+
+// Version adds the version field to the schema that is used for optimistic
+// locking. Updating an entity that was loaded with an outdated version fails
+// with a StaleObjectError.
+type Version struct{ Schema }
+
+// Fields of the version mixin.
+func (Version) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Version(),
+	}
+}
+
+// version mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*Version)(nil)
+
+// SoftDeleteAnnotation marks a schema as soft-deletable, and holds the name of
+// the optional time field that records when an entity was deleted. It is added
+// by the SoftDelete mixin, but can be used directly on schemas that define their
+// own field:
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			mixin.SoftDeleteAnnotation{Field: "deleted_at"},
+//		}
+//	}
+type SoftDeleteAnnotation struct {
+	// Field is the name of the time field that is set on deletion.
+	Field string `json:"Field,omitempty"`
+}
+
+// Name describes the annotation name.
+func (SoftDeleteAnnotation) Name() string {
+	return "SoftDelete"
+}
+
 // AnnotateFields adds field annotations to underlying mixin fields.
 func AnnotateFields(m ent.Mixin, annotations ...schema.Annotation) ent.Mixin {
 	return fieldAnnotator{Mixin: m, annotations: annotations}
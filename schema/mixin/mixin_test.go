@@ -45,6 +45,30 @@ func TestTimeMixin(t *testing.T) {
 	})
 }
 
+func TestSoftDeleteMixin(t *testing.T) {
+	fields := mixin.SoftDelete{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	assert.Equal(t, "delete_time", desc.Name)
+	assert.True(t, desc.Optional)
+	assert.True(t, desc.Nillable)
+	assert.Nil(t, desc.Default)
+	annotations := mixin.SoftDelete{}.Annotations()
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "SoftDelete", annotations[0].Name())
+	assert.Equal(t, mixin.SoftDeleteAnnotation{Field: "delete_time"}, annotations[0])
+}
+
+func TestVersionMixin(t *testing.T) {
+	fields := mixin.Version{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	assert.Equal(t, "version", desc.Name)
+	assert.True(t, desc.Version)
+	assert.True(t, desc.Immutable)
+	assert.Equal(t, 1, desc.Default)
+}
+
 type annotation string
 
 func (annotation) Name() string { return "" }